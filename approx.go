@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"math"
+	"reflect"
+)
+
+const (
+	approxErrorMsg = "val must be a float or complex kind"
+)
+
+// approxEqual returns true if f1 and f2 are equal, or differ by at most absTol, or by at most relTol times the larger magnitude.
+// A NaN is never approximately equal to anything, and an infinity is only equal to the same infinity.
+func approxEqual(f1, f2, absTol, relTol float64) bool {
+	if f1 == f2 {
+		return true
+	}
+
+	if math.IsNaN(f1) || math.IsNaN(f2) || math.IsInf(f1, 0) || math.IsInf(f2, 0) {
+		return false
+	}
+
+	diff := math.Abs(f1 - f2)
+	return (diff <= absTol) || (diff <= relTol*math.Max(math.Abs(f1), math.Abs(f2)))
+}
+
+// ulpEqual returns true if f1 and f2 are at most ulps representable values apart at the given bit size (32 or 64).
+// A NaN is never equal to anything, and -0 == +0.
+func ulpEqual(f1, f2 float64, ulps uint, bitSize int) bool {
+	if f1 == f2 {
+		return true
+	}
+
+	if math.IsNaN(f1) || math.IsNaN(f2) {
+		return false
+	}
+
+	// Map the float bits onto integers that are ordered the same way as the floats, so that the difference is the number of ulps
+	var o1, o2 int64
+	if bitSize == 32 {
+		o1, o2 = orderedBits32(float32(f1)), orderedBits32(float32(f2))
+	} else {
+		o1, o2 = orderedBits64(f1), orderedBits64(f2)
+	}
+
+	var dist uint64
+	if o1 > o2 {
+		dist = uint64(o1) - uint64(o2)
+	} else {
+		dist = uint64(o2) - uint64(o1)
+	}
+
+	return dist <= uint64(ulps)
+}
+
+// orderedBits32 returns the bits of a float32 as an int64, ordered the same way as the float32 values
+func orderedBits32(f float32) int64 {
+	b := int64(int32(math.Float32bits(f)))
+	if b < 0 {
+		b = math.MinInt32 - b
+	}
+
+	return b
+}
+
+// orderedBits64 returns the bits of a float64 as an int64, ordered the same way as the float64 values
+func orderedBits64(f float64) int64 {
+	b := int64(math.Float64bits(f))
+	if b < 0 {
+		b = math.MinInt64 - b
+	}
+
+	return b
+}
+
+// floatEqualTo returns a func(interface{}) bool that converts the arg to the type of val and compares each float part using eq.
+// The func returns false if the arg is nil or not convertible to the type of val.
+// Panics if val is not a float or complex kind.
+func floatEqualTo(val interface{}, eq func(f1, f2 float64, bitSize int) bool) func(interface{}) bool {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+	default:
+		panic(approxErrorMsg)
+	}
+
+	d := deepEqualer{floatEqual: eq}
+
+	return deepEqualTo(val, d.equal)
+}
+
+// ApproxEqualTo (val, absTol, relTol) returns a func(interface{}) bool that returns true if the arg is approximately equal to val.
+// The arg is converted to the type of val first, then compared.
+// Values are approximately equal if they differ by at most absTol, or by at most relTol times the larger magnitude.
+// Complex values are compared by comparing the real and imaginary parts separately.
+// A NaN is never approximately equal to anything, and an infinity is only equal to the same infinity.
+// The func returns false if the arg is nil or not convertible to the type of val.
+// Panics if val is not a float or complex kind.
+func ApproxEqualTo(val interface{}, absTol, relTol float64) func(interface{}) bool {
+	return floatEqualTo(val, func(f1, f2 float64, _ int) bool {
+		return approxEqual(f1, f2, absTol, relTol)
+	})
+}
+
+// ULPEqualTo (val, ulps) returns a func(interface{}) bool that returns true if the arg is at most ulps units in the last place from val.
+// The arg is converted to the type of val first, then compared in the precision of val, so a float32 val counts float32 ulps.
+// Complex values are compared by comparing the real and imaginary parts separately.
+// A NaN is never equal to anything, and -0 == +0.
+// The func returns false if the arg is nil or not convertible to the type of val.
+// Panics if val is not a float or complex kind.
+func ULPEqualTo(val interface{}, ulps uint) func(interface{}) bool {
+	return floatEqualTo(val, func(f1, f2 float64, bitSize int) bool {
+		return ulpEqual(f1, f2, ulps, bitSize)
+	})
+}
+
+// ApproxDeepEqualTo (val, absTol, relTol) returns a func(interface{}) bool that returns true if the arg is deep equal to val,
+// except that floats and complexes anywhere in val are compared as described by ApproxEqualTo.
+// Nils and conversion are handled as described by DeepEqualTo.
+func ApproxDeepEqualTo(val interface{}, absTol, relTol float64) func(interface{}) bool {
+	return DeepEqualToWith(val, ApproxFloats(absTol, relTol))
+}
+
+// ULPDeepEqualTo (val, ulps) returns a func(interface{}) bool that returns true if the arg is deep equal to val,
+// except that floats and complexes anywhere in val are compared as described by ULPEqualTo.
+// Nils and conversion are handled as described by DeepEqualTo.
+func ULPDeepEqualTo(val interface{}, ulps uint) func(interface{}) bool {
+	return DeepEqualToWith(val, ULPFloats(ulps))
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tenth and fifth are variables, so that tenth+fifth is computed at runtime as 0.30000000000000004
+var tenth, fifth = 0.1, 0.2
+
+func TestApproxEqualTo(t *testing.T) {
+	// Absolute tolerance
+	fn := ApproxEqualTo(0.3, 1e-9, 0)
+	assert.True(t, fn(tenth+fifth))
+	assert.False(t, fn(float32(0.3)))
+	assert.False(t, fn(0.31))
+	assert.True(t, ApproxEqualTo(0.3, 0, 0)(0.3))
+	assert.False(t, ApproxEqualTo(0.3, 0, 0)(tenth+fifth))
+
+	// Relative tolerance
+	fn = ApproxEqualTo(1e10, 0, 1e-6)
+	assert.True(t, fn(1e10+1000))
+	assert.False(t, fn(1e10+1e5))
+
+	// Ints are converted
+	assert.True(t, ApproxEqualTo(2.0, 0.1, 0)(2))
+
+	// NaN and infinities
+	assert.False(t, ApproxEqualTo(math.NaN(), 1, 1)(math.NaN()))
+	assert.True(t, ApproxEqualTo(math.Inf(1), 0, 0)(math.Inf(1)))
+	assert.False(t, ApproxEqualTo(math.Inf(1), 1, 1)(math.MaxFloat64))
+
+	// Complex
+	fn = ApproxEqualTo(complex(1, 2), 1e-3, 0)
+	assert.True(t, fn(complex(1.0001, 1.9999)))
+	assert.False(t, fn(complex(1, 2.1)))
+
+	// Not convertible or nil
+	assert.False(t, ApproxEqualTo(1.0, 1, 1)("1"))
+	assert.False(t, ApproxEqualTo(1.0, 1, 1)(nil))
+
+	// Usable as a filter
+	assert.True(t, And(ApproxEqualTo(1.0, 0.5, 0), IsPositive)(1.25))
+
+	func() {
+		defer func() {
+			assert.Equal(t, approxErrorMsg, recover())
+		}()
+
+		ApproxEqualTo(1, 1, 1)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestULPEqualTo(t *testing.T) {
+	var (
+		one     = 1.0
+		next    = math.Nextafter(one, 2)
+		next2   = math.Nextafter(next, 2)
+		prev    = math.Nextafter(one, 0)
+		negZero = math.Copysign(0, -1)
+	)
+
+	fn := ULPEqualTo(one, 1)
+	assert.True(t, fn(one))
+	assert.True(t, fn(next))
+	assert.True(t, fn(prev))
+	assert.False(t, fn(next2))
+	assert.True(t, ULPEqualTo(one, 2)(next2))
+	assert.True(t, ULPEqualTo(0.0, 0)(negZero))
+	assert.True(t, ULPEqualTo(negZero, 2)(math.SmallestNonzeroFloat64))
+	assert.True(t, ULPEqualTo(math.SmallestNonzeroFloat64, 2)(-math.SmallestNonzeroFloat64))
+	assert.False(t, ULPEqualTo(math.SmallestNonzeroFloat64, 1)(-math.SmallestNonzeroFloat64))
+	assert.False(t, ULPEqualTo(math.NaN(), 10)(math.NaN()))
+
+	// float32 counts float32 ulps
+	var (
+		one32  = float32(1)
+		next32 = math.Nextafter32(one32, 2)
+	)
+	assert.True(t, ULPEqualTo(one32, 1)(next32))
+	assert.False(t, ULPEqualTo(one32, 0)(next32))
+	assert.False(t, ULPEqualTo(one, 1)(float64(next32)))
+
+	// Complex
+	assert.True(t, ULPEqualTo(complex(one, one), 1)(complex(next, prev)))
+	assert.False(t, ULPEqualTo(complex(one, one), 1)(complex(next, next2)))
+	assert.True(t, ULPEqualTo(complex64(complex(one32, 0)), 1)(complex64(complex(next32, 0))))
+}
+
+type approxPoint struct {
+	X, Y  float64
+	Label string
+	scale float32
+}
+
+func TestApproxDeepEqualTo(t *testing.T) {
+	var (
+		val = struct {
+			Points []approxPoint
+			ByName map[string]*approxPoint
+			C      complex128
+		}{
+			Points: []approxPoint{{X: tenth + fifth, Y: 1, Label: "a", scale: 0.5}},
+			ByName: map[string]*approxPoint{"b": {X: 2, Y: 3, Label: "b"}},
+			C:      complex(1, 1),
+		}
+		arg = val
+	)
+
+	arg.Points = []approxPoint{{X: 0.3, Y: 1, Label: "a", scale: 0.5}}
+	arg.ByName = map[string]*approxPoint{"b": {X: 2.0000001, Y: 3, Label: "b"}}
+
+	assert.False(t, DeepEqualTo(val)(arg))
+	assert.True(t, ApproxDeepEqualTo(val, 1e-6, 0)(arg))
+	assert.False(t, ApproxDeepEqualTo(val, 1e-9, 0)(arg))
+
+	// Non-float differences are still detected
+	arg.Points = []approxPoint{{X: 0.3, Y: 1, Label: "c", scale: 0.5}}
+	assert.False(t, ApproxDeepEqualTo(val, 1e-6, 0)(arg))
+
+	// Unexported fields are compared
+	arg.Points = []approxPoint{{X: 0.3, Y: 1, Label: "a", scale: 0.6}}
+	assert.False(t, ApproxDeepEqualTo(val, 1e-6, 0)(arg))
+
+	// ULP
+	assert.True(t, ULPDeepEqualTo([]float64{tenth + fifth}, 1)([]float64{0.3}))
+	assert.False(t, ULPDeepEqualTo([]float64{tenth + fifth}, 0)([]float64{0.3}))
+
+	// Nils
+	assert.True(t, ApproxDeepEqualTo(nil, 1, 1)(nil))
+	assert.True(t, ApproxDeepEqualTo([]float64(nil), 1, 1)([]float64(nil)))
+	assert.False(t, ApproxDeepEqualTo([]float64{}, 1, 1)([]float64(nil)))
+
+	// Cycles
+	type node struct {
+		Val  float64
+		Next *node
+	}
+	n1, n2 := &node{Val: 1}, &node{Val: 1.0000001}
+	n1.Next, n2.Next = n1, n2
+	assert.True(t, ApproxDeepEqualTo(n1, 1e-6, 0)(n2))
+	assert.False(t, ApproxDeepEqualTo(n1, 0, 0)(n2))
+}
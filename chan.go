@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+const (
+	chanErrorMsg = "in must be a non-nil channel that can be received from"
+)
+
+// chanReceiver returns a func that receives the next value from a channel of any type.
+// The func returns false if the channel is closed or ctx is done.
+// If in happens to be a chan interface{} or <-chan interface{}, it is received from directly.
+// Panics if in is not a non-nil channel that can be received from.
+func chanReceiver(in interface{}) func(context.Context) (interface{}, bool) {
+	var recv <-chan interface{}
+	switch c := in.(type) {
+	case chan interface{}:
+		recv = c
+	case <-chan interface{}:
+		recv = c
+	}
+
+	if recv != nil {
+		return func(ctx context.Context) (interface{}, bool) {
+			select {
+			case val, ok := <-recv:
+				return val, ok
+			case <-ctx.Done():
+				return nil, false
+			}
+		}
+	}
+
+	rv := reflect.ValueOf(in)
+	if (rv.Kind() != reflect.Chan) || rv.IsNil() || (rv.Type().ChanDir()&reflect.RecvDir == 0) {
+		panic(chanErrorMsg)
+	}
+
+	return func(ctx context.Context) (interface{}, bool) {
+		chosen, val, ok := reflect.Select([]reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: rv},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		})
+
+		if (chosen == 1) || !ok {
+			return nil, false
+		}
+
+		return val.Interface(), true
+	}
+}
+
+// chanSend sends val to out, returning false if ctx is done first
+func chanSend(ctx context.Context, out chan<- interface{}, val interface{}) bool {
+	select {
+	case out <- val:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// FilterChan (ctx, in, fn) returns a channel of the values received from in that fn returns true for.
+// in may be a channel of any type that can be received from, and fn is adapted using Filter.
+// The returned channel is closed when in is closed or ctx is done.
+// Panics if in is not a non-nil channel that can be received from.
+func FilterChan(ctx context.Context, in interface{}, fn interface{}) <-chan interface{} {
+	var (
+		recv     = chanReceiver(in)
+		filterFn = Filter(fn)
+		out      = make(chan interface{})
+	)
+
+	go func() {
+		defer close(out)
+
+		for val, ok := recv(ctx); ok; val, ok = recv(ctx) {
+			if filterFn(val) && !chanSend(ctx, out, val) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// MapChan (ctx, in, fn, buffer) returns a channel with the given buffer size of the results of fn applied to each value received from in.
+// in may be a channel of any type that can be received from, and fn is adapted using Map.
+// The returned channel is closed when in is closed or ctx is done.
+// Panics if in is not a non-nil channel that can be received from.
+func MapChan(ctx context.Context, in interface{}, fn interface{}, buffer uint) <-chan interface{} {
+	var (
+		recv  = chanReceiver(in)
+		mapFn = Map(fn)
+		out   = make(chan interface{}, buffer)
+	)
+
+	go func() {
+		defer close(out)
+
+		for val, ok := recv(ctx); ok; val, ok = recv(ctx) {
+			if !chanSend(ctx, out, mapFn(val)) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// ConsumeChan (ctx, in, fn) passes each value received from in to fn, until in is closed or ctx is done.
+// in may be a channel of any type that can be received from, and fn is adapted using Consumer.
+// Blocks until in is closed or ctx is done, and returns ctx.Err().
+// Panics if in is not a non-nil channel that can be received from.
+func ConsumeChan(ctx context.Context, in interface{}, fn interface{}) error {
+	var (
+		recv       = chanReceiver(in)
+		consumerFn = Consumer(fn)
+	)
+
+	for val, ok := recv(ctx); ok; val, ok = recv(ctx) {
+		consumerFn(val)
+	}
+
+	return ctx.Err()
+}
+
+// SupplyChan (ctx, fn, n) returns a channel of n values provided by fn.
+// fn is adapted using Supplier.
+// If n is 0, values are provided until ctx is done.
+// The returned channel is closed after n values or when ctx is done.
+func SupplyChan(ctx context.Context, fn interface{}, n uint) <-chan interface{} {
+	var (
+		supplierFn = Supplier(fn)
+		out        = make(chan interface{})
+	)
+
+	go func() {
+		defer close(out)
+
+		for i := uint(0); (n == 0) || (i < n); i++ {
+			if !chanSend(ctx, out, supplierFn()) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// FanOut (ctx, in, n) returns n channels that each receive from in, so that n downstream stages can share the values.
+// Each value received from in is sent to exactly one of the returned channels.
+// in may be a channel of any type that can be received from.
+// All returned channels are closed when in is closed or ctx is done.
+// Panics if in is not a non-nil channel that can be received from.
+func FanOut(ctx context.Context, in interface{}, n uint) []<-chan interface{} {
+	var (
+		recv = chanReceiver(in)
+		outs = make([]<-chan interface{}, n)
+	)
+
+	for i := range outs {
+		out := make(chan interface{})
+		outs[i] = out
+
+		go func() {
+			defer close(out)
+
+			for val, ok := recv(ctx); ok; val, ok = recv(ctx) {
+				if !chanSend(ctx, out, val) {
+					return
+				}
+			}
+		}()
+	}
+
+	return outs
+}
+
+// FanIn (ctx, ins...) returns a single channel that receives all values from all the given channels.
+// The returned channel is closed when all given channels are closed or ctx is done.
+func FanIn(ctx context.Context, ins ...<-chan interface{}) <-chan interface{} {
+	var (
+		out = make(chan interface{})
+		wg  sync.WaitGroup
+	)
+
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(recv func(context.Context) (interface{}, bool)) {
+			defer wg.Done()
+
+			for val, ok := recv(ctx); ok; val, ok = recv(ctx) {
+				if !chanSend(ctx, out, val) {
+					return
+				}
+			}
+		}(chanReceiver(in))
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
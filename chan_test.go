@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// collect receives all values from a channel until it is closed
+func collect(in <-chan interface{}) []interface{} {
+	res := []interface{}{}
+	for val := range in {
+		res = append(res, val)
+	}
+
+	return res
+}
+
+// intsChan returns a closed buffered chan int containing the given values
+func intsChan(vals ...int) chan int {
+	in := make(chan int, len(vals))
+	for _, val := range vals {
+		in <- val
+	}
+	close(in)
+
+	return in
+}
+
+func TestFilterChan(t *testing.T) {
+	ctx := context.Background()
+
+	// Typed channel
+	assert.Equal(t, []interface{}{2, 4}, collect(FilterChan(ctx, intsChan(1, 2, 3, 4), func(i int) bool { return i%2 == 0 })))
+
+	// chan interface{}
+	in := make(chan interface{}, 2)
+	in <- 1
+	in <- 2
+	close(in)
+	assert.Equal(t, []interface{}{1}, collect(FilterChan(ctx, in, func(i int) bool { return i < 2 })))
+
+	// Cancelled context closes downstream
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, []interface{}{}, collect(FilterChan(cctx, make(chan int), func(int) bool { return true })))
+
+	deferFunc := func() {
+		assert.Equal(t, chanErrorMsg, recover())
+	}
+
+	func() {
+		defer deferFunc()
+
+		// Not a channel
+		FilterChan(ctx, 5, func(int) bool { return true })
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer deferFunc()
+
+		// Nil channel
+		var c chan int
+		FilterChan(ctx, c, func(int) bool { return true })
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer deferFunc()
+
+		// Send only channel
+		FilterChan(ctx, make(chan<- int), func(int) bool { return true })
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestMapChan(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, []interface{}{2, 4, 6}, collect(MapChan(ctx, intsChan(1, 2, 3), func(i int) int { return i * 2 }, 0)))
+	assert.Equal(t, []interface{}{"a"}, collect(MapChan(ctx, intsChan(97), func(r rune) string { return string(r) }, 1)))
+
+	// Cancelled context closes downstream
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, []interface{}{}, collect(MapChan(cctx, make(chan int), func(i int) int { return i }, 0)))
+}
+
+func TestConsumeChan(t *testing.T) {
+	ctx := context.Background()
+
+	sum := 0
+	assert.Nil(t, ConsumeChan(ctx, intsChan(1, 2, 3), func(i int) { sum += i }))
+	assert.Equal(t, 6, sum)
+
+	// Cancelled context returns error
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, context.Canceled, ConsumeChan(cctx, make(chan int), func(int) {}))
+}
+
+func TestSupplyChan(t *testing.T) {
+	ctx := context.Background()
+
+	i := 0
+	assert.Equal(t, []interface{}{1, 2, 3}, collect(SupplyChan(ctx, func() int { i++; return i }, 3)))
+
+	// Unlimited until cancelled
+	cctx, cancel := context.WithCancel(ctx)
+	var (
+		in  = SupplyChan(cctx, func() int { return 1 }, 0)
+		res []interface{}
+	)
+	for val := range in {
+		res = append(res, val)
+		if len(res) == 5 {
+			break
+		}
+	}
+	cancel()
+	assert.Equal(t, []interface{}{1, 1, 1, 1, 1}, res)
+
+	// Drain so the supplying goroutine sees the cancel
+	collect(in)
+}
+
+func TestFanOutFanIn(t *testing.T) {
+	ctx := context.Background()
+
+	// Each value goes to exactly one of the fanned out channels, and fanning in collects them all
+	outs := FanOut(ctx, intsChan(1, 2, 3, 4, 5, 6), 3)
+	assert.Equal(t, 3, len(outs))
+
+	mapped := make([]<-chan interface{}, len(outs))
+	for i, out := range outs {
+		mapped[i] = MapChan(ctx, out, func(i int) int { return i * 10 }, 0)
+	}
+
+	res := collect(FanIn(ctx, mapped...))
+	sort.Slice(res, func(i, j int) bool { return res[i].(int) < res[j].(int) })
+	assert.Equal(t, []interface{}{10, 20, 30, 40, 50, 60}, res)
+
+	// No channels
+	assert.Equal(t, []interface{}{}, collect(FanIn(ctx)))
+
+	// Cancelled context closes downstream
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	for _, out := range FanOut(cctx, make(chan int), 2) {
+		assert.Equal(t, []interface{}{}, collect(out))
+	}
+	assert.Equal(t, []interface{}{}, collect(FanIn(cctx, make(chan interface{}))))
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"sync"
+)
+
+// Comparator is a func(val1, val2 interface{}) int that returns -1 if val1 < val2, 0 if val1 == val2, and 1 if val1 > val2.
+// Comparators can be chained to declaratively describe multi-key orderings.
+type Comparator func(val1, val2 interface{}) int
+
+// sign returns -1, 0, or 1 for a negative, zero, or positive compare result
+func sign(res int) int {
+	switch {
+	case res < 0:
+		return -1
+	case res > 0:
+		return 1
+	}
+
+	return 0
+}
+
+// ComparatorOf (fn) adapts a func(val1, val2 any) bool that returns true if val1 < val2 into a Comparator.
+// If fn happens to be a Comparator, it is returned as is.
+// If fn is a func(val1, val2 interface{}) int that returns a negative, zero, or positive result, it is adapted to return -1, 0, or 1.
+// Otherwise, fn is adapted using SortFunc.
+// Panics if fn is not a Comparator, func(val1, val2 interface{}) int, or a func SortFunc accepts.
+func ComparatorOf(fn interface{}) Comparator {
+	switch f := fn.(type) {
+	case Comparator:
+		return f
+	case func(val1, val2 interface{}) int:
+		return func(val1, val2 interface{}) int {
+			return sign(f(val1, val2))
+		}
+	}
+
+	lt := SortFunc(fn)
+
+	return func(val1, val2 interface{}) int {
+		if lt(val1, val2) {
+			return -1
+		}
+
+		if lt(val2, val1) {
+			return 1
+		}
+
+		return 0
+	}
+}
+
+// ComparatorByKey (key, fn) returns a Comparator that compares the results of applying key to each value.
+// key is adapted using Map.
+// fn may be anything ComparatorOf accepts to compare the keys, or nil to compare the keys the same way as LessThan.
+// If fn is nil, nil keys are ordered first as described by NullsFirst, and the keys are compared as the type of the first non-nil key compared,
+// where keys that cannot be ordered, such as a float NaN, are considered equal.
+// The Comparator panics if fn is nil and a non-nil key is not lessable.
+func ComparatorByKey(key interface{}, fn interface{}) Comparator {
+	var (
+		keyFn = Map(key)
+		cmp   Comparator
+	)
+
+	if IsNil(fn) {
+		var (
+			once       sync.Once
+			keyCompare func(key1, key2 interface{}) int
+		)
+
+		cmp = Comparator(func(key1, key2 interface{}) int {
+			// NullsFirst ensures the keys are non-nil.
+			// Check them before the Once, so that a key that is not lessable does not leave keyCompare unset.
+			PanicBM(IsLessable(key1) && IsLessable(key2), lessThanErrorMsg)
+			once.Do(func() {
+				keyCompare = compareFunc(key1)
+			})
+
+			if res := keyCompare(key1, key2); res != unordered {
+				return res
+			}
+
+			return 0
+		}).NullsFirst()
+	} else {
+		cmp = ComparatorOf(fn)
+	}
+
+	return func(val1, val2 interface{}) int {
+		return cmp(keyFn(val1), keyFn(val2))
+	}
+}
+
+// Less returns a func(val1, val2 interface{}) bool that returns true if val1 < val2 according to c.
+// The result is suitable for use with SortFunc and sort.Slice.
+func (c Comparator) Less() func(val1, val2 interface{}) bool {
+	return func(val1, val2 interface{}) bool {
+		return c(val1, val2) < 0
+	}
+}
+
+// Reversed returns a Comparator that orders values in the reverse order of c
+func (c Comparator) Reversed() Comparator {
+	return func(val1, val2 interface{}) int {
+		return c(val2, val1)
+	}
+}
+
+// ThenBy (fn) returns a Comparator that orders values using c, then orders values c considers equal using fn.
+// fn may be anything ComparatorOf accepts.
+func (c Comparator) ThenBy(fn interface{}) Comparator {
+	next := ComparatorOf(fn)
+
+	return func(val1, val2 interface{}) int {
+		if res := c(val1, val2); res != 0 {
+			return res
+		}
+
+		return next(val1, val2)
+	}
+}
+
+// ThenByKey (key, fn) returns a Comparator that orders values using c, then orders values c considers equal using ComparatorByKey(key, fn).
+func (c Comparator) ThenByKey(key interface{}, fn interface{}) Comparator {
+	return c.ThenBy(ComparatorByKey(key, fn))
+}
+
+// NullsFirst returns a Comparator that orders nil values before non-nil values, and orders non-nil values using c.
+// Values are nil according to IsNil, and two nil values are considered equal.
+func (c Comparator) NullsFirst() Comparator {
+	return func(val1, val2 interface{}) int {
+		switch nil1, nil2 := IsNil(val1), IsNil(val2); {
+		case nil1 && nil2:
+			return 0
+		case nil1:
+			return -1
+		case nil2:
+			return 1
+		}
+
+		return c(val1, val2)
+	}
+}
+
+// NullsLast returns a Comparator that orders nil values after non-nil values, and orders non-nil values using c.
+// Values are nil according to IsNil, and two nil values are considered equal.
+func (c Comparator) NullsLast() Comparator {
+	return func(val1, val2 interface{}) int {
+		switch nil1, nil2 := IsNil(val1), IsNil(val2); {
+		case nil1 && nil2:
+			return 0
+		case nil1:
+			return 1
+		case nil2:
+			return -1
+		}
+
+		return c(val1, val2)
+	}
+}
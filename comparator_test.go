@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type comparatorPerson struct {
+	Name string
+	Age  int
+}
+
+func TestComparatorOf(t *testing.T) {
+	cmp := ComparatorOf(func(val1, val2 int) bool { return val1 < val2 })
+	assert.Equal(t, -1, cmp(1, 2))
+	assert.Equal(t, 0, cmp(2, 2))
+	assert.Equal(t, 1, cmp(int8(3), 2))
+
+	// Exact match
+	cmp = ComparatorOf(func(val1, val2 interface{}) int { return val1.(int) - val2.(int) })
+	assert.Equal(t, 1, cmp(5, 2))
+	assert.Equal(t, -1, cmp(2, 5))
+	assert.Equal(t, 0, cmp(2, 2))
+	assert.Equal(t, cmp(5, 2), ComparatorOf(cmp)(5, 2))
+
+	// Less
+	lt := ComparatorOf(IntSortFunc).Less()
+	assert.True(t, lt(1, 2))
+	assert.False(t, lt(2, 2))
+
+	// Reversed
+	cmp = ComparatorOf(IntSortFunc).Reversed()
+	assert.Equal(t, 1, cmp(1, 2))
+	assert.Equal(t, 0, cmp(2, 2))
+	assert.Equal(t, -1, cmp(3, 2))
+
+	func() {
+		defer func() {
+			assert.Equal(t, sortErrorMsg, recover())
+		}()
+
+		ComparatorOf(func(int) bool { return true })
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestComparatorByKey(t *testing.T) {
+	// Natural ordering of keys
+	cmp := ComparatorByKey(func(p comparatorPerson) string { return p.Name }, nil)
+	assert.Equal(t, -1, cmp(comparatorPerson{Name: "a"}, comparatorPerson{Name: "b"}))
+	assert.Equal(t, 0, cmp(comparatorPerson{Name: "a"}, comparatorPerson{Name: "a"}))
+	assert.Equal(t, 1, cmp(comparatorPerson{Name: "b"}, comparatorPerson{Name: "a"}))
+
+	// Keys are compared as the type of the first non-nil key, with nil keys first
+	cmp = ComparatorByKey(func(p *comparatorPerson) interface{} {
+		if p == nil {
+			return nil
+		}
+		return p.Age
+	}, nil)
+	assert.Equal(t, -1, cmp(&comparatorPerson{Age: 1}, &comparatorPerson{Age: 2}))
+	var nobody *comparatorPerson
+	assert.Equal(t, -1, cmp(nobody, &comparatorPerson{Age: 1}))
+	assert.Equal(t, 1, cmp(&comparatorPerson{Age: 1}, nobody))
+	assert.Equal(t, 0, cmp(nobody, nobody))
+
+	// NaN keys cannot be ordered, so are equal
+	cmp = ComparatorByKey(func(f float64) float64 { return f }, nil)
+	assert.Equal(t, 0, cmp(math.NaN(), 1.0))
+	assert.Equal(t, -1, cmp(0.0, 1.0))
+
+	// A key that is not lessable panics on every call, not just the first
+	cmp = ComparatorByKey(func(val interface{}) interface{} { return val }, nil)
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				assert.Equal(t, lessThanErrorMsg, recover())
+			}()
+
+			cmp(struct{}{}, struct{}{})
+			assert.Fail(t, "must panic")
+		}()
+	}
+	assert.Equal(t, -1, cmp(1, 2))
+
+	// Custom ordering of keys
+	cmp = ComparatorByKey(func(p comparatorPerson) int { return p.Age }, func(val1, val2 int) bool { return val1 > val2 })
+	assert.Equal(t, -1, cmp(comparatorPerson{Age: 2}, comparatorPerson{Age: 1}))
+}
+
+func TestComparatorThenBy(t *testing.T) {
+	people := []comparatorPerson{
+		{Name: "b", Age: 2},
+		{Name: "a", Age: 2},
+		{Name: "c", Age: 1},
+		{Name: "a", Age: 3},
+	}
+
+	// Age descending, then name ascending
+	lt := ComparatorByKey(func(p comparatorPerson) int { return p.Age }, nil).
+		Reversed().
+		ThenByKey(func(p comparatorPerson) string { return p.Name }, nil).
+		Less()
+
+	sort.Slice(people, func(i, j int) bool { return lt(people[i], people[j]) })
+	assert.Equal(t, []comparatorPerson{
+		{Name: "a", Age: 3},
+		{Name: "a", Age: 2},
+		{Name: "b", Age: 2},
+		{Name: "c", Age: 1},
+	}, people)
+
+	// ThenBy a less func
+	cmp := ComparatorByKey(func(p comparatorPerson) int { return p.Age }, nil).
+		ThenBy(func(p1, p2 comparatorPerson) bool { return p1.Name < p2.Name })
+	assert.Equal(t, -1, cmp(comparatorPerson{Name: "a", Age: 1}, comparatorPerson{Name: "b", Age: 1}))
+	assert.Equal(t, 1, cmp(comparatorPerson{Name: "a", Age: 2}, comparatorPerson{Name: "b", Age: 1}))
+}
+
+func TestComparatorNulls(t *testing.T) {
+	var (
+		one  = 1
+		two  = 2
+		null *int
+		cmp  = ComparatorOf(func(val1, val2 *int) bool { return *val1 < *val2 })
+	)
+
+	first := cmp.NullsFirst()
+	assert.Equal(t, 0, first(null, nil))
+	assert.Equal(t, -1, first(null, &one))
+	assert.Equal(t, 1, first(&one, null))
+	assert.Equal(t, -1, first(&one, &two))
+
+	last := cmp.NullsLast()
+	assert.Equal(t, 0, last(null, nil))
+	assert.Equal(t, 1, last(null, &one))
+	assert.Equal(t, -1, last(&one, null))
+	assert.Equal(t, 1, last(&two, &one))
+}
@@ -0,0 +1,386 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"reflect"
+)
+
+const (
+	equalFuncErrorMsg = "fn must be a non-nil function of two arguments of the same type and return bool"
+)
+
+// DeepEqualOption is an option for DeepEqualToWith
+type DeepEqualOption func(*deepEqualer)
+
+// deepVisit is a pair of pointers of the same type that are being compared, to detect cycles.
+// Slices of the same array with different lengths are different values, so the lengths of slices are part of the visit.
+type deepVisit struct {
+	ptr1, ptr2 uintptr
+	len1, len2 int
+	typ        reflect.Type
+}
+
+// newDeepVisit returns the deepVisit for two non-nil pointers, maps, or slices of the same type
+func newDeepVisit(rv1, rv2 reflect.Value) deepVisit {
+	visit := deepVisit{ptr1: rv1.Pointer(), ptr2: rv2.Pointer(), typ: rv1.Type()}
+	if rv1.Kind() == reflect.Slice {
+		visit.len1, visit.len2 = rv1.Len(), rv2.Len()
+	}
+
+	return visit
+}
+
+// deepEqualer walks two values of the same type the same way as reflect.DeepEqual, except as modified by the DeepEqualOptions.
+// If floatEqual is nil, floats and the parts of complexes are compared using ==.
+// EqualTo sets pointerIdentity to compare pointers and funcs by address,
+// and collectionIdentity to compare slices and maps by identity, which no option sets.
+type deepEqualer struct {
+	pointerIdentity     bool
+	collectionIdentity  bool
+	floatEqual          func(f1, f2 float64, bitSize int) bool
+	ignoreFields        map[string]bool
+	nilEqualsEmpty      bool
+	unorderedSlices     bool
+	equalFuncs          map[reflect.Type]reflect.Value
+	ignoreUnexported    map[reflect.Type]bool
+	ignoreAllUnexported bool
+	visited             map[deepVisit]bool
+	visitLog            []deepVisit
+}
+
+// IgnoreFields (paths) is a DeepEqualOption that skips the struct fields at the given paths.
+// A path is a dot separated list of field names from the top level value, eg "Address.Street".
+// Slice, array, and map elements, pointers, and interfaces do not add to the path,
+// so "Addresses.Street" refers to the Street field of every element of an Addresses slice.
+// Embedded structs are named by their type name, the same as in Go.
+func IgnoreFields(paths ...string) DeepEqualOption {
+	return func(d *deepEqualer) {
+		if d.ignoreFields == nil {
+			d.ignoreFields = map[string]bool{}
+		}
+
+		for _, path := range paths {
+			d.ignoreFields[path] = true
+		}
+	}
+}
+
+// NilEqualsEmpty is a DeepEqualOption that considers a nil slice or map equal to an empty slice or map of the same type
+func NilEqualsEmpty(d *deepEqualer) {
+	d.nilEqualsEmpty = true
+}
+
+// UnorderedSlices is a DeepEqualOption that compares slices and arrays as unordered collections.
+// Each element of one must be deep equal to a different element of the other, in any order.
+// A pairing is found if one exists, even if element equality is not transitive, as with ApproxFloats.
+// The comparison takes time at least proportional to the square of the length.
+func UnorderedSlices(d *deepEqualer) {
+	d.unorderedSlices = true
+}
+
+// EqualFunc (fn) is a DeepEqualOption that compares all values of type X using a func(X, X) bool.
+// If X is a Ptr type, fn receives the pointers, and is used instead of comparing what they point to.
+// fn is not used for values read from unexported fields, as reflection cannot pass them to a func.
+// Panics if fn is not a non-nil func that accepts two args of the same type and returns bool.
+func EqualFunc(fn interface{}) DeepEqualOption {
+	vfn := reflect.ValueOf(fn)
+	PanicBM(vfn.Kind() == reflect.Func && !vfn.IsNil(), equalFuncErrorMsg)
+
+	typ := vfn.Type()
+	PanicBM((typ.NumIn() == 2) &&
+		(typ.NumOut() == 1) &&
+		(typ.In(0) == typ.In(1)) &&
+		(typ.Out(0).Kind() == reflect.Bool),
+		equalFuncErrorMsg,
+	)
+
+	return func(d *deepEqualer) {
+		if d.equalFuncs == nil {
+			d.equalFuncs = map[reflect.Type]reflect.Value{}
+		}
+
+		d.equalFuncs[typ.In(0)] = vfn
+	}
+}
+
+// IgnoreUnexported (vals) is a DeepEqualOption that skips the unexported fields of the struct types of the given values.
+// If no values are given, the unexported fields of all structs are skipped.
+// By default, unexported fields are compared, the same as reflect.DeepEqual.
+func IgnoreUnexported(vals ...interface{}) DeepEqualOption {
+	return func(d *deepEqualer) {
+		if len(vals) == 0 {
+			d.ignoreAllUnexported = true
+			return
+		}
+
+		if d.ignoreUnexported == nil {
+			d.ignoreUnexported = map[reflect.Type]bool{}
+		}
+
+		for _, val := range vals {
+			d.ignoreUnexported[reflect.TypeOf(val)] = true
+		}
+	}
+}
+
+// ApproxFloats (absTol, relTol) is a DeepEqualOption that compares floats and the parts of complexes as described by ApproxEqualTo
+func ApproxFloats(absTol, relTol float64) DeepEqualOption {
+	return func(d *deepEqualer) {
+		d.floatEqual = func(f1, f2 float64, _ int) bool {
+			return approxEqual(f1, f2, absTol, relTol)
+		}
+	}
+}
+
+// ULPFloats (ulps) is a DeepEqualOption that compares floats and the parts of complexes as described by ULPEqualTo
+func ULPFloats(ulps uint) DeepEqualOption {
+	return func(d *deepEqualer) {
+		d.floatEqual = func(f1, f2 float64, bitSize int) bool {
+			return ulpEqual(f1, f2, ulps, bitSize)
+		}
+	}
+}
+
+// DeepEqualToWith (val, opts) returns a func(interface{}) bool that returns true if the func arg is deep equal to val,
+// where deep equality is the same as reflect.DeepEqual, except as modified by the opts.
+// Nils and conversion are handled as described by DeepEqualTo.
+func DeepEqualToWith(val interface{}, opts ...DeepEqualOption) func(interface{}) bool {
+	var d deepEqualer
+	for _, opt := range opts {
+		opt(&d)
+	}
+
+	return deepEqualTo(val, d.equal)
+}
+
+// equal returns true if val1 and val2 are deep equal.
+// Each call uses a copy of d with its own visited map, so d can be shared by concurrent callers.
+// The visited map is only allocated when pointers, maps, or slices are walked.
+func (d deepEqualer) equal(val1, val2 interface{}) bool {
+	walker := d
+	walker.visited, walker.visitLog = nil, nil
+
+	return walker.equalValues(reflect.ValueOf(val1), reflect.ValueOf(val2), "")
+}
+
+// equalFloats compares two floats of the given bit size using floatEqual, or == if floatEqual is nil
+func (d *deepEqualer) equalFloats(f1, f2 float64, bitSize int) bool {
+	if d.floatEqual == nil {
+		return f1 == f2
+	}
+
+	return d.floatEqual(f1, f2, bitSize)
+}
+
+// equalElements compares the elements of two slices or arrays of the same length, in order or unordered
+func (d *deepEqualer) equalElements(rv1, rv2 reflect.Value, path string) bool {
+	n := rv1.Len()
+
+	if !d.unorderedSlices {
+		for i := 0; i < n; i++ {
+			if !d.equalValues(rv1.Index(i), rv2.Index(i), path) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// trial compares two elements, removing the visits it added if they are not equal,
+	// so that pointers compared by a failed trial are not considered equal by a later one
+	trial := func(i, j int) bool {
+		mark := len(d.visitLog)
+		if d.equalValues(rv1.Index(i), rv2.Index(j), path) {
+			return true
+		}
+
+		for _, visit := range d.visitLog[mark:] {
+			delete(d.visited, visit)
+		}
+		d.visitLog = d.visitLog[:mark]
+
+		return false
+	}
+
+	// Element equality need not be transitive, eg for approximate floats, so a greedy match can fail when a matching exists.
+	// Find a matching using augmenting paths, where each pair of elements is compared at most once.
+	var (
+		results = make([]int8, n*n)
+		matchOf = make([]int, n)
+		augment func(i int, seen []bool) bool
+	)
+
+	for j := range matchOf {
+		matchOf[j] = -1
+	}
+
+	equal := func(i, j int) bool {
+		if results[i*n+j] == 0 {
+			results[i*n+j] = 2
+			if trial(i, j) {
+				results[i*n+j] = 1
+			}
+		}
+
+		return results[i*n+j] == 1
+	}
+
+	// augment matches element i of rv1, rematching the element of rv1 matched to a candidate if necessary
+	augment = func(i int, seen []bool) bool {
+		for j := 0; j < n; j++ {
+			if !seen[j] && equal(i, j) {
+				seen[j] = true
+				if (matchOf[j] < 0) || augment(matchOf[j], seen) {
+					matchOf[j] = i
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		if !augment(i, make([]bool, n)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalValues returns true if rv1 and rv2 are deep equal, where path is the path of struct fields to rv1 and rv2.
+// Unexported fields are compared without calling Interface(), so they do not cause a panic.
+func (d *deepEqualer) equalValues(rv1, rv2 reflect.Value, path string) bool {
+	if !rv1.IsValid() || !rv2.IsValid() {
+		return rv1.IsValid() == rv2.IsValid()
+	}
+
+	if rv1.Type() != rv2.Type() {
+		return false
+	}
+
+	// Use a custom equality func if there is one, and the values can be passed to it
+	if fn, haveIt := d.equalFuncs[rv1.Type()]; haveIt && rv1.CanInterface() && rv2.CanInterface() {
+		return fn.Call([]reflect.Value{rv1, rv2})[0].Bool()
+	}
+
+	// Pointers, maps, and slices may be cyclic, so consider them equal if they are already being compared
+	switch rv1.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if rv1.IsNil() || rv2.IsNil() {
+			if d.nilEqualsEmpty && (rv1.Kind() != reflect.Ptr) {
+				return (rv1.Len() == 0) && (rv2.Len() == 0)
+			}
+
+			return rv1.IsNil() == rv2.IsNil()
+		}
+
+		if (d.pointerIdentity && (rv1.Kind() == reflect.Ptr)) || (d.collectionIdentity && (rv1.Kind() != reflect.Ptr)) {
+			// Slices must also have the same length
+			return (rv1.Pointer() == rv2.Pointer()) && ((rv1.Kind() != reflect.Slice) || (rv1.Len() == rv2.Len()))
+		}
+
+		visit := newDeepVisit(rv1, rv2)
+		if d.visited[visit] {
+			return true
+		}
+
+		if d.visited == nil {
+			d.visited = map[deepVisit]bool{}
+		}
+		d.visited[visit] = true
+
+		// Unordered slices undo the visits of elements that are not equal
+		if d.unorderedSlices {
+			d.visitLog = append(d.visitLog, visit)
+		}
+	}
+
+	switch rv1.Kind() {
+	case reflect.Bool:
+		return rv1.Bool() == rv2.Bool()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv1.Int() == rv2.Int()
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv1.Uint() == rv2.Uint()
+
+	case reflect.Float32, reflect.Float64:
+		return d.equalFloats(rv1.Float(), rv2.Float(), rv1.Type().Bits())
+
+	case reflect.Complex64, reflect.Complex128:
+		var (
+			c1, c2  = rv1.Complex(), rv2.Complex()
+			bitSize = rv1.Type().Bits() / 2
+		)
+
+		return d.equalFloats(real(c1), real(c2), bitSize) && d.equalFloats(imag(c1), imag(c2), bitSize)
+
+	case reflect.String:
+		return rv1.String() == rv2.String()
+
+	case reflect.Array, reflect.Slice:
+		return (rv1.Len() == rv2.Len()) && d.equalElements(rv1, rv2, path)
+
+	case reflect.Map:
+		if rv1.Len() != rv2.Len() {
+			return false
+		}
+
+		for mr := rv1.MapRange(); mr.Next(); {
+			if val2 := rv2.MapIndex(mr.Key()); !val2.IsValid() || !d.equalValues(mr.Value(), val2, path) {
+				return false
+			}
+		}
+
+		return true
+
+	case reflect.Ptr, reflect.Interface:
+		if rv1.IsNil() || rv2.IsNil() {
+			return rv1.IsNil() == rv2.IsNil()
+		}
+
+		return d.equalValues(rv1.Elem(), rv2.Elem(), path)
+
+	case reflect.Struct:
+		typ := rv1.Type()
+		for i, n := 0, rv1.NumField(); i < n; i++ {
+			var (
+				field     = typ.Field(i)
+				fieldPath string
+			)
+
+			// Paths are only needed to ignore fields
+			if d.ignoreFields != nil {
+				if fieldPath = field.Name; path != "" {
+					fieldPath = path + "." + field.Name
+				}
+			}
+
+			if d.ignoreFields[fieldPath] ||
+				((field.PkgPath != "") && (d.ignoreAllUnexported || d.ignoreUnexported[typ])) {
+				continue
+			}
+
+			if !d.equalValues(rv1.Field(i), rv2.Field(i), fieldPath) {
+				return false
+			}
+		}
+
+		return true
+
+	case reflect.Func:
+		if d.pointerIdentity {
+			return rv1.Pointer() == rv2.Pointer()
+		}
+
+		// Funcs are only equal if both are nil, the same as reflect.DeepEqual
+		return rv1.IsNil() && rv2.IsNil()
+	}
+
+	// Chan and UnsafePointer are equal if they are the same pointer
+	return rv1.Pointer() == rv2.Pointer()
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type deepAddress struct {
+	Street string
+	City   string
+}
+
+type deepCustomer struct {
+	Name      string
+	Addresses []deepAddress
+	Tags      []string
+	Attrs     map[string]int
+	Updated   time.Time
+	version   int
+}
+
+func TestDeepEqualToWith(t *testing.T) {
+	var (
+		now = time.Date(2020, 1, 2, 3, 4, 5, 6000000, time.FixedZone("EST", -5*60*60))
+		val = deepCustomer{
+			Name:      "Al",
+			Addresses: []deepAddress{{Street: "1 Main", City: "Ottawa"}},
+			Tags:      []string{"a", "b"},
+			Updated:   now,
+			version:   1,
+		}
+		arg = val
+	)
+
+	// No options is the same as DeepEqualTo
+	assert.True(t, DeepEqualToWith(val)(arg))
+	assert.True(t, DeepEqualToWith(nil)(nil))
+	assert.False(t, DeepEqualToWith(val)(nil))
+
+	// IgnoreFields
+	arg.Addresses = []deepAddress{{Street: "2 Main", City: "Ottawa"}}
+	arg.Updated = now.Add(time.Second)
+	assert.False(t, DeepEqualToWith(val)(arg))
+	assert.False(t, DeepEqualToWith(val, IgnoreFields("Updated"))(arg))
+	assert.True(t, DeepEqualToWith(val, IgnoreFields("Updated", "Addresses.Street"))(arg))
+	assert.False(t, DeepEqualToWith(val, IgnoreFields("Updated", "Street"))(arg))
+	arg.Addresses, arg.Updated = val.Addresses, val.Updated
+
+	// NilEqualsEmpty
+	arg.Attrs = map[string]int{}
+	assert.False(t, DeepEqualToWith(val)(arg))
+	assert.True(t, DeepEqualToWith(val, NilEqualsEmpty)(arg))
+	assert.True(t, DeepEqualToWith([]int(nil), NilEqualsEmpty)([]int{}))
+	assert.False(t, DeepEqualToWith([]int(nil), NilEqualsEmpty)([]int{1}))
+	arg.Attrs = nil
+
+	// Sub-slices of the same array with different lengths are different values
+	s, u := []int{1, 2, 3}, []int{1, 2, 4}
+	assert.False(t, DeepEqualToWith([][]int{s[:2], s[:3]})([][]int{u[:2], u[:3]}))
+	assert.True(t, DeepEqualToWith([][]int{s[:2], s[:3]})([][]int{s[:2], s[:3]}))
+
+	// UnorderedSlices
+	arg.Tags = []string{"b", "a"}
+	assert.False(t, DeepEqualToWith(val)(arg))
+	assert.True(t, DeepEqualToWith(val, UnorderedSlices)(arg))
+	assert.True(t, DeepEqualToWith([]int{1, 1, 2}, UnorderedSlices)([]int{1, 2, 1}))
+	assert.False(t, DeepEqualToWith([]int{1, 1, 2}, UnorderedSlices)([]int{1, 2, 2}))
+	assert.True(t, DeepEqualToWith([2]int{1, 2}, UnorderedSlices)([2]int{2, 1}))
+
+	// A pairing is found when the first match of an element must be given up for a later one
+	assert.True(t, DeepEqualToWith([]float64{1.2, 0.8}, UnorderedSlices, ApproxFloats(0.5, 0))([]float64{0.9, 1.6}))
+	assert.False(t, DeepEqualToWith([]float64{1.2, 0.8}, UnorderedSlices, ApproxFloats(0.5, 0))([]float64{0.9, 1.8}))
+
+	// A failed trial match of pointers must not leave them marked as visited
+	type pnode struct{ Val int }
+	a, b, c := &pnode{1}, &pnode{2}, &pnode{1}
+	assert.False(t, DeepEqualToWith([]*pnode{a, a}, UnorderedSlices)([]*pnode{b, c}))
+	assert.True(t, DeepEqualToWith([]*pnode{a, a}, UnorderedSlices)([]*pnode{c, c}))
+	arg.Tags = val.Tags
+
+	// IgnoreUnexported
+	arg.version = 2
+	assert.False(t, DeepEqualToWith(val)(arg))
+	assert.True(t, DeepEqualToWith(val, IgnoreUnexported())(arg))
+	assert.True(t, DeepEqualToWith(val, IgnoreUnexported(deepCustomer{}))(arg))
+	assert.False(t, DeepEqualToWith(val, IgnoreUnexported(deepAddress{}))(arg))
+	arg.version = val.version
+
+	// EqualFunc
+	arg.Updated = now.In(time.UTC).Add(time.Nanosecond)
+	assert.False(t, DeepEqualToWith(val)(arg))
+	timeEq := EqualFunc(func(t1, t2 time.Time) bool {
+		return t1.Truncate(time.Millisecond).Equal(t2.Truncate(time.Millisecond))
+	})
+	assert.True(t, DeepEqualToWith(val, timeEq)(arg))
+	arg.Updated = now.Add(time.Millisecond)
+	assert.False(t, DeepEqualToWith(val, timeEq)(arg))
+	arg.Updated = now.In(time.UTC)
+	assert.True(t, DeepEqualToWith(val, timeEq)(arg))
+	assert.True(t, DeepEqualToWith([]string{"A"}, EqualFunc(strings.EqualFold))([]string{"a"}))
+
+	// Floats
+	assert.True(t, DeepEqualToWith([]float64{tenth + fifth}, ApproxFloats(1e-9, 0))([]float64{0.3}))
+	assert.True(t, DeepEqualToWith([]float64{tenth + fifth}, ULPFloats(1))([]float64{0.3}))
+	assert.False(t, DeepEqualToWith([]float64{tenth + fifth})([]float64{0.3}))
+
+	// Combined
+	arg = val
+	arg.Tags = []string{"b", "a"}
+	arg.Attrs = map[string]int{}
+	arg.version = 3
+	assert.True(t, DeepEqualToWith(val, UnorderedSlices, NilEqualsEmpty, IgnoreUnexported())(arg))
+
+	func() {
+		defer func() {
+			assert.Equal(t, equalFuncErrorMsg, recover())
+		}()
+
+		EqualFunc(func(int, string) bool { return false })
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, equalFuncErrorMsg, recover())
+		}()
+
+		EqualFunc(nil)
+		assert.Fail(t, "must panic")
+	}()
+}
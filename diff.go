@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// DiffReason describes why two values differ
+type DiffReason string
+
+const (
+	// DiffTypes means the values are of different types
+	DiffTypes DiffReason = "types differ"
+
+	// DiffValues means the values are of the same type, but not equal
+	DiffValues DiffReason = "values differ"
+
+	// DiffNil means one value is nil and the other is not
+	DiffNil DiffReason = "nil and non-nil"
+
+	// DiffMissing means a slice or array element or map key exists in the expected value, but not the actual value
+	DiffMissing DiffReason = "missing"
+
+	// DiffExtra means a slice or array element or map key exists in the actual value, but not the expected value
+	DiffExtra DiffReason = "extra"
+)
+
+// Difference is a single difference between an expected and actual value, found by Diff.
+// Path locates the difference from the top level value, which has an empty path.
+// Struct fields are written as .Field, slice and array elements as [index], and map values as [key] using %#v for the key.
+// Pointers and interfaces do not add to the path.
+// Expected and Actual are the differing values, which are nil for a missing or extra element.
+// A value read from an unexported field cannot be returned as an interface{}, so it is formatted as a string with %v.
+type Difference struct {
+	Path     string
+	Expected interface{}
+	Actual   interface{}
+	Reason   DiffReason
+}
+
+// String returns a readable description of the difference
+func (d Difference) String() string {
+	path := d.Path
+	if path == "" {
+		path = "(root)"
+	}
+
+	return fmt.Sprintf("%s: %s: expected %v, actual %v", path, d.Reason, d.Expected, d.Actual)
+}
+
+// differ walks two values and records their differences
+type differ struct {
+	visited map[deepVisit]bool
+	diffs   []Difference
+}
+
+// valueOf returns the value of rv as an interface{}, nil if rv is invalid, or a string if rv was read from an unexported field
+func valueOf(rv reflect.Value) interface{} {
+	if !rv.IsValid() {
+		return nil
+	}
+
+	if rv.CanInterface() {
+		return rv.Interface()
+	}
+
+	return fmt.Sprintf("%v", rv)
+}
+
+// add records a difference
+func (d *differ) add(path string, rv1, rv2 reflect.Value, reason DiffReason) {
+	d.diffs = append(d.diffs, Difference{
+		Path:     path,
+		Expected: valueOf(rv1),
+		Actual:   valueOf(rv2),
+		Reason:   reason,
+	})
+}
+
+// diff records the differences between rv1 and rv2, where path is the path to them.
+// Values are compared the same way as reflect.DeepEqual.
+func (d *differ) diff(rv1, rv2 reflect.Value, path string) {
+	if !rv1.IsValid() || !rv2.IsValid() {
+		if rv1.IsValid() != rv2.IsValid() {
+			d.add(path, rv1, rv2, DiffTypes)
+		}
+
+		return
+	}
+
+	if rv1.Type() != rv2.Type() {
+		d.add(path, rv1, rv2, DiffTypes)
+		return
+	}
+
+	// Pointers, maps, and slices may be cyclic, so do not walk a pair that is already being walked
+	switch rv1.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		if rv1.IsNil() || rv2.IsNil() {
+			if rv1.IsNil() != rv2.IsNil() {
+				d.add(path, rv1, rv2, DiffNil)
+			}
+
+			return
+		}
+
+		if rv1.Kind() != reflect.Interface {
+			visit := newDeepVisit(rv1, rv2)
+			if d.visited[visit] {
+				return
+			}
+			d.visited[visit] = true
+		}
+	}
+
+	switch rv1.Kind() {
+	case reflect.Array, reflect.Slice:
+		n1, n2 := rv1.Len(), rv2.Len()
+		for i := 0; (i < n1) || (i < n2); i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+
+			switch {
+			case i >= n2:
+				d.add(elemPath, rv1.Index(i), reflect.Value{}, DiffMissing)
+			case i >= n1:
+				d.add(elemPath, reflect.Value{}, rv2.Index(i), DiffExtra)
+			default:
+				d.diff(rv1.Index(i), rv2.Index(i), elemPath)
+			}
+		}
+
+	case reflect.Map:
+		// Walk the keys of both maps in a stable order, so the differences are always reported in the same order
+		type mapKey struct {
+			key reflect.Value
+			str string
+		}
+
+		var keys []mapKey
+		for _, key := range rv1.MapKeys() {
+			keys = append(keys, mapKey{key, fmt.Sprintf("%#v", valueOf(key))})
+		}
+
+		for _, key := range rv2.MapKeys() {
+			if !rv1.MapIndex(key).IsValid() {
+				keys = append(keys, mapKey{key, fmt.Sprintf("%#v", valueOf(key))})
+			}
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].str < keys[j].str
+		})
+
+		for _, key := range keys {
+			var (
+				keyPath    = fmt.Sprintf("%s[%s]", path, key.str)
+				val1, val2 = rv1.MapIndex(key.key), rv2.MapIndex(key.key)
+			)
+
+			switch {
+			case !val2.IsValid():
+				d.add(keyPath, val1, val2, DiffMissing)
+			case !val1.IsValid():
+				d.add(keyPath, val1, val2, DiffExtra)
+			default:
+				d.diff(val1, val2, keyPath)
+			}
+		}
+
+	case reflect.Ptr, reflect.Interface:
+		d.diff(rv1.Elem(), rv2.Elem(), path)
+
+	case reflect.Struct:
+		typ := rv1.Type()
+		for i, n := 0, rv1.NumField(); i < n; i++ {
+			d.diff(rv1.Field(i), rv2.Field(i), path+"."+typ.Field(i).Name)
+		}
+
+	default:
+		// All other kinds are compared the same way as DeepEqualTo
+		var leaf deepEqualer
+		if !leaf.equalValues(rv1, rv2, path) {
+			d.add(path, rv1, rv2, DiffValues)
+		}
+	}
+}
+
+// Diff (expected, actual) returns the differences between expected and actual, or an empty slice if they are deep equal.
+// Structs, arrays, slices, maps, pointers, and interfaces are walked, and all other values are compared as reflect.DeepEqual does.
+// Cycles are detected, so cyclic values can be compared.
+// Map keys are reported in order of their %#v strings, so the result is the same for every call.
+func Diff(expected, actual interface{}) []Difference {
+	d := differ{
+		visited: map[deepVisit]bool{},
+		diffs:   []Difference{},
+	}
+
+	d.diff(reflect.ValueOf(expected), reflect.ValueOf(actual), "")
+
+	return d.diffs
+}
+
+// DeepEqualToDiff (val) returns a func(interface{}) bool that behaves like DeepEqualTo(val),
+// and a func() []Difference that returns the differences found by the most recent call of the first func.
+// The arg is converted to the type of val when possible, and the differences are between val and the converted arg.
+// The second func returns nil if the first func has not been called yet.
+// Both funcs are safe for concurrent use, although the last difference is then that of whichever call finished last.
+func DeepEqualToDiff(val interface{}) (func(interface{}) bool, func() []Difference) {
+	var (
+		valTyp = reflect.TypeOf(val)
+		mu     sync.Mutex
+		last   []Difference
+	)
+
+	equalTo := func(arg interface{}) bool {
+		if argTyp := reflect.TypeOf(arg); (valTyp != nil) && (argTyp != nil) && argTyp.ConvertibleTo(valTyp) {
+			arg = reflect.ValueOf(arg).Convert(valTyp).Interface()
+		}
+
+		diffs := Diff(val, arg)
+
+		mu.Lock()
+		last = diffs
+		mu.Unlock()
+
+		return len(diffs) == 0
+	}
+
+	lastDiff := func() []Difference {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return last
+	}
+
+	return equalTo, lastDiff
+}
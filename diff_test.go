@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	// Equal values
+	assert.Equal(t, []Difference{}, Diff(nil, nil))
+	assert.Equal(t, []Difference{}, Diff(1, 1))
+	assert.Equal(t, []Difference{}, Diff(deepCustomer{Tags: []string{"a"}}, deepCustomer{Tags: []string{"a"}}))
+
+	// Top level
+	assert.Equal(t, []Difference{{Expected: 1, Actual: 2, Reason: DiffValues}}, Diff(1, 2))
+	assert.Equal(t, []Difference{{Expected: 1, Actual: "1", Reason: DiffTypes}}, Diff(1, "1"))
+	assert.Equal(t, []Difference{{Expected: 1, Reason: DiffTypes}}, Diff(1, nil))
+	assert.Equal(t, []Difference{{Expected: []int(nil), Actual: []int{}, Reason: DiffNil}}, Diff([]int(nil), []int{}))
+
+	// Structs, slices, maps, and pointers
+	var (
+		expected = deepCustomer{
+			Name:      "Al",
+			Addresses: []deepAddress{{Street: "1 Main", City: "Ottawa"}, {Street: "2 Main", City: "Ottawa"}},
+			Tags:      []string{"a"},
+			Attrs:     map[string]int{"a": 1, "b": 2},
+			version:   1,
+		}
+		actual = deepCustomer{
+			Name:      "Al",
+			Addresses: []deepAddress{{Street: "1 Main", City: "Toronto"}},
+			Tags:      []string{"a", "b"},
+			Attrs:     map[string]int{"a": 1, "b": 3, "c": 4},
+			version:   2,
+		}
+	)
+
+	assert.Equal(
+		t,
+		[]Difference{
+			{Path: ".Addresses[0].City", Expected: "Ottawa", Actual: "Toronto", Reason: DiffValues},
+			{Path: ".Addresses[1]", Expected: deepAddress{Street: "2 Main", City: "Ottawa"}, Reason: DiffMissing},
+			{Path: ".Tags[1]", Actual: "b", Reason: DiffExtra},
+			{Path: `.Attrs["b"]`, Expected: 2, Actual: 3, Reason: DiffValues},
+			{Path: `.Attrs["c"]`, Actual: 4, Reason: DiffExtra},
+			{Path: ".version", Expected: "1", Actual: "2", Reason: DiffValues},
+		},
+		Diff(expected, actual),
+	)
+
+	assert.Equal(
+		t,
+		[]Difference{{Path: "[2]", Expected: 3, Reason: DiffMissing}},
+		Diff(&[]int{1, 2, 3}, &[]int{1, 2}),
+	)
+
+	assert.Equal(
+		t,
+		[]Difference{{Path: "[0]", Expected: 1, Actual: "1", Reason: DiffTypes}},
+		Diff([]interface{}{1}, []interface{}{"1"}),
+	)
+
+	// Cycles
+	type node struct {
+		Val  int
+		Next *node
+	}
+	n1, n2 := &node{Val: 1}, &node{Val: 1}
+	n1.Next, n2.Next = n1, n2
+	assert.Equal(t, []Difference{}, Diff(n1, n2))
+	n2.Val = 2
+	assert.Equal(t, []Difference{{Path: ".Val", Expected: 1, Actual: 2, Reason: DiffValues}}, Diff(n1, n2))
+
+	// Sub-slices of the same array with different lengths are walked separately
+	s, u := []int{1, 2, 3}, []int{1, 2, 4}
+	assert.Equal(t, []Difference{{Path: "[1][2]", Expected: 3, Actual: 4, Reason: DiffValues}}, Diff([][]int{s[:2], s[:3]}, [][]int{u[:2], u[:3]}))
+
+	assert.Equal(t, `.Attrs["b"]: values differ: expected 2, actual 3`, Diff(expected, actual)[3].String())
+	assert.Equal(t, "(root): values differ: expected 1, actual 2", Diff(1, 2)[0].String())
+}
+
+func TestDeepEqualToDiff(t *testing.T) {
+	equalTo, lastDiff := DeepEqualToDiff([]int{1, 2})
+	assert.Nil(t, lastDiff())
+
+	assert.True(t, equalTo([]int{1, 2}))
+	assert.Equal(t, []Difference{}, lastDiff())
+
+	assert.False(t, equalTo([]int{1, 3}))
+	assert.Equal(t, []Difference{{Path: "[1]", Expected: 2, Actual: 3, Reason: DiffValues}}, lastDiff())
+
+	// Conversion
+	type ints []int
+	assert.True(t, equalTo(ints{1, 2}))
+	assert.False(t, equalTo("1, 2"))
+	assert.Equal(t, []Difference{{Expected: []int{1, 2}, Actual: "1, 2", Reason: DiffTypes}}, lastDiff())
+	assert.False(t, equalTo(nil))
+
+	// Same results as DeepEqualTo
+	equalTo, _ = DeepEqualToDiff(nil)
+	assert.True(t, equalTo(nil))
+	assert.False(t, equalTo([]int(nil)))
+
+	s, u := []int{1, 2, 3}, []int{1, 2, 4}
+	equalTo, _ = DeepEqualToDiff([][]int{s[:2], s[:3]})
+	assert.False(t, equalTo([][]int{u[:2], u[:3]}))
+
+	equalTo, _ = DeepEqualToDiff([]int(nil))
+	assert.True(t, equalTo([]int(nil)))
+	assert.False(t, equalTo([]int{}))
+}
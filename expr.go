@@ -0,0 +1,609 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ExprError is an error parsing an expression given to CompileExpr.
+// Offset is the byte offset of the error in the expression, and Line and Column are the 1-based line and rune column.
+type ExprError struct {
+	Offset int
+	Line   int
+	Column int
+	Msg    string
+}
+
+// Error returns the message with the line and column
+func (e ExprError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+// newExprError returns an ExprError for the given offset of src
+func newExprError(src string, offset int, format string, args ...interface{}) ExprError {
+	var (
+		before = src[:offset]
+		line   = strings.Count(before, "\n") + 1
+		column = utf8.RuneCountInString(before[strings.LastIndex(before, "\n")+1:]) + 1
+	)
+
+	return ExprError{
+		Offset: offset,
+		Line:   line,
+		Column: column,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// exprTokenKind is the kind of an expression token
+type exprTokenKind uint
+
+const (
+	exprEOF exprTokenKind = iota
+	exprIdent
+	exprString
+	exprNumber
+	exprPunct
+)
+
+// exprToken is a token of an expression, where val is the value of a string or number
+type exprToken struct {
+	kind   exprTokenKind
+	text   string
+	offset int
+	val    interface{}
+}
+
+// exprPuncts are the punctuation tokens, with two character tokens first so they are matched before their prefixes
+var exprPuncts = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", ",", "."}
+
+// exprTokens splits src into tokens, ending with an exprEOF token
+func exprTokens(src string) ([]exprToken, error) {
+	var toks []exprToken
+
+NEXT:
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			continue
+
+		case unicode.IsLetter(r) || (r == '_'):
+			start := i
+			for i < len(src) {
+				if r, size = utf8.DecodeRuneInString(src[i:]); !unicode.IsLetter(r) && !unicode.IsDigit(r) && (r != '_') {
+					break
+				}
+				i += size
+			}
+
+			toks = append(toks, exprToken{kind: exprIdent, text: src[start:i], offset: start})
+			continue
+
+		case r == '"':
+			// Find the closing quote, skipping escaped characters, and let strconv handle the escapes
+			start := i
+			for i++; (i < len(src)) && (src[i] != '"'); i++ {
+				if src[i] == '\\' {
+					i++
+				}
+			}
+
+			if i >= len(src) {
+				return nil, newExprError(src, start, "unterminated string")
+			}
+
+			i++
+			str, err := strconv.Unquote(src[start:i])
+			if err != nil {
+				return nil, newExprError(src, start, "invalid string %s", src[start:i])
+			}
+
+			toks = append(toks, exprToken{kind: exprString, text: src[start:i], offset: start, val: str})
+			continue
+
+		case isASCIIDigit(r) || ((r == '-') && (i+1 < len(src)) && isASCIIDigit(rune(src[i+1]))):
+			// A number is an optional sign, digits and dots, and an optional exponent with an optional sign
+			start := i
+			for i++; (i < len(src)) && (isASCIIDigit(rune(src[i])) || (src[i] == '.')); i++ {
+			}
+
+			if (i < len(src)) && ((src[i] == 'e') || (src[i] == 'E')) {
+				if i++; (i < len(src)) && ((src[i] == '+') || (src[i] == '-')) {
+					i++
+				}
+
+				for ; (i < len(src)) && isASCIIDigit(rune(src[i])); i++ {
+				}
+			}
+
+			// A number cannot run into a name, eg 1abc or 0x1F
+			invalid := false
+			for i < len(src) {
+				if r, size = utf8.DecodeRuneInString(src[i:]); !unicode.IsLetter(r) && !unicode.IsDigit(r) && (r != '_') {
+					break
+				}
+				i += size
+				invalid = true
+			}
+
+			text := src[start:i]
+			if invalid {
+				return nil, newExprError(src, start, "invalid number %s", text)
+			}
+
+			// Integers too large for an int64 are parsed as a uint64 before a float64, so they are not rounded
+			if n, err := strconv.ParseInt(text, 10, 64); err == nil {
+				toks = append(toks, exprToken{kind: exprNumber, text: text, offset: start, val: n})
+			} else if u, err := strconv.ParseUint(text, 10, 64); err == nil {
+				toks = append(toks, exprToken{kind: exprNumber, text: text, offset: start, val: u})
+			} else if f, err := strconv.ParseFloat(text, 64); err == nil {
+				toks = append(toks, exprToken{kind: exprNumber, text: text, offset: start, val: f})
+			} else {
+				return nil, newExprError(src, start, "invalid number %s", text)
+			}
+			continue
+		}
+
+		for _, punct := range exprPuncts {
+			if strings.HasPrefix(src[i:], punct) {
+				toks = append(toks, exprToken{kind: exprPunct, text: punct, offset: i})
+				i += len(punct)
+				continue NEXT
+			}
+		}
+
+		return nil, newExprError(src, i, "unexpected character %q", r)
+	}
+
+	return append(toks, exprToken{kind: exprEOF, offset: len(src)}), nil
+}
+
+// exprParser is a recursive descent parser of a token list
+type exprParser struct {
+	src  string
+	toks []exprToken
+	pos  int
+}
+
+// peek returns the current token
+func (p *exprParser) peek() exprToken {
+	return p.toks[p.pos]
+}
+
+// next returns the current token and advances to the next one
+func (p *exprParser) next() exprToken {
+	tok := p.toks[p.pos]
+	if tok.kind != exprEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+// isPunct returns true if the current token is the given punctuation
+func (p *exprParser) isPunct(text string) bool {
+	tok := p.peek()
+	return (tok.kind == exprPunct) && (tok.text == text)
+}
+
+// errorf returns an ExprError at the given token
+func (p *exprParser) errorf(tok exprToken, format string, args ...interface{}) error {
+	return newExprError(p.src, tok.offset, format, args...)
+}
+
+// unexpected returns an ExprError describing the given token as unexpected
+func (p *exprParser) unexpected(tok exprToken, expected string) error {
+	if tok.kind == exprEOF {
+		return p.errorf(tok, "expected %s, found end of expression", expected)
+	}
+
+	return p.errorf(tok, "expected %s, found %s", expected, tok.text)
+}
+
+// expect consumes the given punctuation, or returns an error
+func (p *exprParser) expect(text string) error {
+	if !p.isPunct(text) {
+		return p.unexpected(p.peek(), strconv.Quote(text))
+	}
+
+	p.next()
+	return nil
+}
+
+// parseOr parses and ( "||" and )*
+func (p *exprParser) parseOr() (func(interface{}) bool, error) {
+	fn, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	fns := []interface{}{fn}
+	for p.isPunct("||") {
+		p.next()
+
+		if fn, err = p.parseAnd(); err != nil {
+			return nil, err
+		}
+		fns = append(fns, fn)
+	}
+
+	if len(fns) == 1 {
+		return fn, nil
+	}
+
+	return Or(fns...), nil
+}
+
+// parseAnd parses unary ( "&&" unary )*
+func (p *exprParser) parseAnd() (func(interface{}) bool, error) {
+	fn, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	fns := []interface{}{fn}
+	for p.isPunct("&&") {
+		p.next()
+
+		if fn, err = p.parseUnary(); err != nil {
+			return nil, err
+		}
+		fns = append(fns, fn)
+	}
+
+	if len(fns) == 1 {
+		return fn, nil
+	}
+
+	return And(fns...), nil
+}
+
+// parseUnary parses "!" unary | "(" or ")" | comparison
+func (p *exprParser) parseUnary() (func(interface{}) bool, error) {
+	if p.isPunct("!") {
+		p.next()
+
+		fn, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not(fn), nil
+	}
+
+	if p.isPunct("(") {
+		p.next()
+
+		fn, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return fn, nil
+	}
+
+	return p.parseComparison()
+}
+
+// parsePath parses ident ( "." ident )*
+func (p *exprParser) parsePath() ([]string, error) {
+	tok := p.next()
+	if tok.kind != exprIdent {
+		return nil, p.unexpected(tok, "field name")
+	}
+
+	names := []string{tok.text}
+	for p.isPunct(".") {
+		p.next()
+
+		if tok = p.next(); tok.kind != exprIdent {
+			return nil, p.unexpected(tok, "field name")
+		}
+		names = append(names, tok.text)
+	}
+
+	return names, nil
+}
+
+// parseValue parses a string, number, true, false, or nil
+func (p *exprParser) parseValue() (interface{}, exprToken, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case exprString, exprNumber:
+		return tok.val, tok, nil
+
+	case exprIdent:
+		switch tok.text {
+		case "true":
+			return true, tok, nil
+		case "false":
+			return false, tok, nil
+		case "nil":
+			return nil, tok, nil
+		}
+	}
+
+	return nil, tok, p.unexpected(tok, "value")
+}
+
+// parseList parses "[" value ( "," value )* "]"
+func (p *exprParser) parseList() ([]interface{}, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+
+	var vals []interface{}
+	for {
+		val, _, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+
+		if !p.isPunct(",") {
+			break
+		}
+		p.next()
+	}
+
+	if err := p.expect("]"); err != nil {
+		return nil, err
+	}
+
+	return vals, nil
+}
+
+// isNumeric returns true if val is of an int, uint, or float kind
+func isNumeric(val interface{}) bool {
+	switch reflect.ValueOf(val).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// exprEqualTo returns a func(interface{}) bool that returns true if the arg is equal to val.
+// Numbers are compared using NumericExact, so an int field can be compared to a float literal.
+// An arg of a different kind than val is not equal, as described by exprSameKind.
+func exprEqualTo(val interface{}) func(interface{}) bool {
+	if val == nil {
+		return IsNil
+	}
+
+	if isNumeric(val) {
+		return exprSameKind(val, And(IsLessThanEquals(val, NumericExact), IsGreaterThanEquals(val, NumericExact)))
+	}
+
+	return exprSameKind(val, EqualTo(val))
+}
+
+// exprOrdering returns a func(interface{}) bool that applies an ordering func such as IsLessThan to val,
+// using NumericExact for numbers, and returning false for a nil arg or an arg of a different kind than val.
+func exprOrdering(fn func(interface{}, ...CompareOption) func(interface{}) bool, val interface{}) func(interface{}) bool {
+	var opts []CompareOption
+	if isNumeric(val) {
+		opts = append(opts, NumericExact)
+	}
+
+	return exprSameKind(val, fn(val, opts...))
+}
+
+// exprKind returns reflect.String, reflect.Bool, or reflect.Float64 for a string, bool, or numeric kind val,
+// else reflect.Invalid
+func exprKind(val interface{}) reflect.Kind {
+	if isNumeric(val) {
+		return reflect.Float64
+	}
+
+	switch kind := reflect.ValueOf(val).Kind(); kind {
+	case reflect.String, reflect.Bool:
+		return kind
+	}
+
+	return reflect.Invalid
+}
+
+// exprSameKind returns a func(interface{}) bool that returns false if the arg is nil,
+// or val is a string, bool, or number and the arg is not of the same kind, else the result of fn.
+// This prevents fn from panicking or converting between kinds, such as an int to a string of one rune.
+func exprSameKind(val interface{}, fn func(interface{}) bool) func(interface{}) bool {
+	kind := exprKind(val)
+
+	return func(arg interface{}) bool {
+		return (!IsNil(arg)) && ((kind == reflect.Invalid) || (exprKind(arg) == kind)) && fn(arg)
+	}
+}
+
+// exprOrderings are the ordering operators and the funcs that implement them
+var exprOrderings = map[string]func(interface{}, ...CompareOption) func(interface{}) bool{
+	"<":  IsLessThan,
+	"<=": IsLessThanEquals,
+	">":  IsGreaterThan,
+	">=": IsGreaterThanEquals,
+}
+
+// exprStringOps are the string operators and the funcs that implement them
+var exprStringOps = map[string]func(string) func(interface{}) bool{
+	"startsWith": HasPrefix,
+	"endsWith":   HasSuffix,
+	"contains":   Contains,
+}
+
+// parseComparison parses path [ op value | "in" list ]
+func (p *exprParser) parseComparison() (func(interface{}) bool, error) {
+	start := p.peek().offset
+	names, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		opTok = p.peek()
+		op    = opTok.text
+		fn    func(interface{}) bool
+	)
+
+	switch {
+	case (opTok.kind == exprPunct) && ((op == "==") || (op == "!=")):
+		p.next()
+
+		val, _, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		if fn = exprEqualTo(val); op == "!=" {
+			fn = Not(fn)
+		}
+
+	case (opTok.kind == exprPunct) && (exprOrderings[op] != nil):
+		p.next()
+
+		val, valTok, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		if !IsLessable(val) {
+			return nil, p.errorf(valTok, "%s cannot be compared with %s", valTok.text, op)
+		}
+
+		fn = exprOrdering(exprOrderings[op], val)
+
+	case (opTok.kind == exprIdent) && ((exprStringOps[op] != nil) || (op == "matches")):
+		p.next()
+
+		val, valTok, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		str, isa := val.(string)
+		if !isa {
+			return nil, p.errorf(valTok, "%s requires a string, found %s", op, valTok.text)
+		}
+
+		if op == "matches" {
+			if _, err := regexp.Compile(str); err != nil {
+				return nil, p.errorf(valTok, "invalid regular expression %s: %s", valTok.text, err)
+			}
+
+			fn = exprSameKind(str, MatchesRegexp(str))
+		} else {
+			fn = exprSameKind(str, exprStringOps[op](str))
+		}
+
+	case (opTok.kind == exprIdent) && (op == "in"):
+		p.next()
+
+		vals, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+
+		fns := make([]interface{}, len(vals))
+		for i, val := range vals {
+			fns[i] = exprEqualTo(val)
+		}
+
+		fn = Or(fns...)
+
+	default:
+		// A path on its own must be a true bool
+		fn = EqualTo(true)
+	}
+
+	// Label the comparison with its source text, so that it can be identified in a Trace
+	return Named(strings.TrimSpace(p.src[start:p.peek().offset]), func(arg interface{}) bool {
+		rv, ok := fieldPathValue(arg, names, exprField)
+		return ok && fn(rv.Interface())
+	}), nil
+}
+
+// exprField is the fieldLookup of expressions and predicates, which looks up a struct field by its exact name,
+// or if there is no such field, case insensitively, or a key of a map with string keys.
+func exprField(rv reflect.Value, name string) (reflect.Value, bool) {
+	switch rv.Kind() {
+	case reflect.Struct:
+		if index := fieldIndex(rv.Type(), name, true); index != nil {
+			return fieldByIndex(rv, index)
+		}
+
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			rv = rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key()))
+			return rv, rv.IsValid()
+		}
+	}
+
+	return rv, false
+}
+
+// CompileExpr (expr) compiles a boolean expression into a func(interface{}) bool that evaluates it against the arg.
+//
+// The expression compares fields of the arg to values, and combines comparisons with &&, ||, !, and parentheses,
+// where && has higher precedence than ||, eg: age >= 18 && name startsWith "A" || status in ["x", "y"].
+//
+// A field is a path of names separated by dots, where each name is an exported struct field or a key of a map with string keys.
+// A struct field name is matched exactly, or if there is no such field, case insensitively, so age matches a field named Age.
+// Pointers and interfaces on the path are dereferenced, and promoted fields of embedded structs are included.
+// A comparison is false if a field or key does not exist, or there is a nil pointer on the path.
+//
+// A value is a double quoted string with Go escapes, a decimal number with an optional fraction and exponent, true, false, or nil.
+// The comparisons are:
+//   - field == value and field != value, using EqualTo for strings and bools, IsNil for nil, and NumericExact for numbers
+//   - field < value, <=, >, and >=, using IsLessThan and friends, and NumericExact for numbers
+//   - field startsWith "str", endsWith "str", contains "str", and matches "regexp", using HasPrefix, HasSuffix, Contains, and MatchesRegexp
+//   - field in [value, ...], which is true if field == any value
+//   - field on its own, which is true if the field is the bool true
+//
+// Ordering and string comparisons are false if the field is nil.
+// A string, number, or bool value is only compared to a field of a string, numeric, or bool kind, respectively,
+// so a comparison with a field of another kind is false, and != is true.
+//
+// Returns an ExprError with the position of the first syntax error.
+func CompileExpr(expr string) (func(interface{}) bool, error) {
+	toks, err := exprTokens(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := exprParser{src: expr, toks: toks}
+
+	fn, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != exprEOF {
+		return nil, p.unexpected(tok, "&&, ||, or end of expression")
+	}
+
+	return fn, nil
+}
+
+// MustCompileExpr (expr) is like CompileExpr, except that it panics if the expression is invalid
+func MustCompileExpr(expr string) func(interface{}) bool {
+	fn, err := CompileExpr(expr)
+	PanicE(err)
+
+	return fn
+}
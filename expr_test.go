@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type exprUser struct {
+	Name    string
+	Age     int
+	Status  string
+	Score   float64
+	Active  bool
+	Address *fieldAddress
+	Labels  map[string]string
+	status  string
+}
+
+func TestCompileExpr(t *testing.T) {
+	var (
+		al = exprUser{Name: "Al", Age: 20, Status: "x", Score: 1.5, Active: true, Address: &fieldAddress{City: "Ottawa"}, Labels: map[string]string{"tier": "gold"}}
+		bo = &exprUser{Name: "Bo", Age: 15, Status: "z", status: "y"}
+		cy = map[string]interface{}{"name": "Cy", "age": 30, "status": "y", "address": map[string]string{"city": "Paris"}}
+	)
+
+	fn := MustCompileExpr(`age >= 18 && name startsWith "A" || status in ["x","y"]`)
+	assert.True(t, fn(al))
+	assert.False(t, fn(bo))
+	assert.True(t, fn(cy))
+	assert.False(t, fn(nil))
+
+	for expr, expected := range map[string][3]bool{
+		// Precedence and grouping
+		`age >= 18 && (name startsWith "A" || status == "y")`: {true, false, true},
+		`!(age < 18)`:                            {true, false, true},
+		`!active`:                                {false, true, true},
+		`active`:                                 {true, false, false},
+		`age > 17.5 && age <= 20`:                {true, false, false},
+		`age == 20 || age == 30.0`:               {true, false, true},
+		`age != 20`:                              {false, true, true},
+		`age in [15, 30]`:                        {false, true, true},
+		`score > 1 && score < 2`:                 {true, false, false},
+		`score == 1.5`:                           {true, false, false},
+		`Name endsWith "o"`:                      {false, true, false},
+		`name contains "y" || name matches "^A"`: {true, false, true},
+		`address.city == "Ottawa"`:               {true, false, false},
+		`address.city != "Ottawa"`:               {false, false, true},
+		`address.city matches "^P"`:              {false, false, true},
+		`address == nil`:                         {false, true, false},
+		`address != nil`:                         {true, false, true},
+		`labels.tier == "gold"`:                  {true, false, false},
+		`missing == nil || missing != nil`:       {false, false, false},
+		`status == "y"`:                          {false, false, true},
+		`name > "B" && name < "Cz"`:              {false, true, true},
+		`age >= -1 && age < 1e2`:                 {true, true, true},
+		`age > 1.5e+1`:                           {true, false, true},
+
+		// Type mismatches are false, and never convert between kinds
+		`name == 5`:          {false, false, false},
+		`name != 5`:          {true, true, true},
+		`name > 5`:           {false, false, false},
+		`age == "20"`:        {false, false, false},
+		`age < "a"`:          {false, false, false},
+		`age startsWith "2"`: {false, false, false},
+		`age matches "2"`:    {false, false, false},
+		`active == 1`:        {false, false, false},
+		`name == true`:       {false, false, false},
+		`age in ["20", 20]`:  {true, false, false},
+	} {
+		fn := MustCompileExpr(expr)
+		assert.Equal(t, expected, [3]bool{fn(al), fn(bo), fn(cy)}, expr)
+	}
+
+	// A string literal does not match an int that converts to a rune
+	code := MustCompileExpr(`code == "A"`)
+	assert.False(t, code(map[string]interface{}{"code": 65}))
+	assert.True(t, code(map[string]interface{}{"code": "A"}))
+
+	// Integers above the int64 range are not rounded to a float64
+	maxUint := MustCompileExpr(`a == 18446744073709551615`)
+	assert.True(t, maxUint(map[string]interface{}{"a": uint64(math.MaxUint64)}))
+	assert.False(t, maxUint(map[string]interface{}{"a": uint64(math.MaxUint64 - 1)}))
+	assert.True(t, MustCompileExpr(`a > 9223372036854775807`)(map[string]interface{}{"a": uint64(math.MaxInt64 + 1)}))
+
+	// Usable with other funcs
+	assert.True(t, And(MustCompileExpr(`age > 18`), Where("Name", HasPrefix("A")))(al))
+}
+
+func TestCompileExprErrors(t *testing.T) {
+	for expr, expected := range map[string]ExprError{
+		``:                       {0, 1, 1, "expected field name, found end of expression"},
+		`age >`:                  {5, 1, 6, "expected value, found end of expression"},
+		`age > 1 &&`:             {10, 1, 11, "expected field name, found end of expression"},
+		`age > 1 age`:            {8, 1, 9, "expected &&, ||, or end of expression, found age"},
+		`(age > 1`:               {8, 1, 9, `expected ")", found end of expression`},
+		`age > true`:             {6, 1, 7, "true cannot be compared with >"},
+		`age < nil`:              {6, 1, 7, "nil cannot be compared with <"},
+		`name startsWith 1`:      {16, 1, 17, "startsWith requires a string, found 1"},
+		`name matches "["`:       {13, 1, 14, "invalid regular expression \"[\": error parsing regexp: missing closing ]: `[`"},
+		`status in "x"`:          {10, 1, 11, `expected "[", found "x"`},
+		`status in ["x" "y"]`:    {15, 1, 16, `expected "]", found "y"`},
+		`name == "abc`:           {8, 1, 9, "unterminated string"},
+		`name == "\q"`:           {8, 1, 9, `invalid string "\q"`},
+		`age == 1.2.3`:           {7, 1, 8, "invalid number 1.2.3"},
+		`age == 1abc`:            {7, 1, 8, "invalid number 1abc"},
+		`age == 0x1F`:            {7, 1, 8, "invalid number 0x1F"},
+		`age == 1_000`:           {7, 1, 8, "invalid number 1_000"},
+		`age == 1e`:              {7, 1, 8, "invalid number 1e"},
+		"age > 1 &&\n  namé # 2": {19, 2, 8, `unexpected character '#'`},
+		`address. == 1`:          {9, 1, 10, "expected field name, found =="},
+		`age = 1`:                {4, 1, 5, `unexpected character '='`},
+	} {
+		_, err := CompileExpr(expr)
+		assert.Equal(t, expected, err, expr)
+	}
+
+	_, err := CompileExpr("age >\n)")
+	assert.Equal(t, "2:1: expected value, found )", err.Error())
+
+	func() {
+		defer func() {
+			assert.Equal(t, "1:6: expected value, found end of expression", recover())
+		}()
+
+		MustCompileExpr(`age >`)
+		assert.Fail(t, "must panic")
+	}()
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	fieldNameErrorMsg = "%q is not a valid exported field name"
+	fieldPathErrorMsg = "%q is not a valid path of exported field names separated by dots"
+	noFieldErrorMsg   = "%s has no field named %s"
+	notStructErrorMsg = "%s is not a struct, so it has no field named %s"
+)
+
+// fieldKey is a struct type and field name, and whether the name is matched case insensitively, the key of fieldIndexes
+type fieldKey struct {
+	typ  reflect.Type
+	name string
+	fold bool
+}
+
+// fieldIndexes caches the index sequence of each fieldKey looked up, as a map of fieldKey to []int.
+// A field that does not exist is cached as nil.
+var fieldIndexes sync.Map
+
+// fieldLookup returns the value named name in rv, which is neither a pointer nor an interface, or false if there is no such value
+type fieldLookup func(rv reflect.Value, name string) (reflect.Value, bool)
+
+// isExportedName returns true if name is a valid exported Go identifier
+func isExportedName(name string) bool {
+	for i, r := range name {
+		if i == 0 {
+			if !unicode.IsUpper(r) {
+				return false
+			}
+		} else if !unicode.IsLetter(r) && !unicode.IsDigit(r) && (r != '_') {
+			return false
+		}
+	}
+
+	return name != ""
+}
+
+// fieldIndex returns the index sequence of the named field of a struct type, which may be promoted from an embedded struct.
+// If fold is true and there is no exported field of the exact name, the only exported field with the same name under Unicode case folding is used.
+// Returns nil if the struct has no such field.
+func fieldIndex(typ reflect.Type, name string, fold bool) []int {
+	key := fieldKey{typ, name, fold}
+	if index, haveIt := fieldIndexes.Load(key); haveIt {
+		return index.([]int)
+	}
+
+	field, haveIt := typ.FieldByName(name)
+	if fold && (!haveIt || (field.PkgPath != "")) {
+		field, haveIt = typ.FieldByNameFunc(func(fieldName string) bool {
+			return isExportedName(fieldName) && strings.EqualFold(fieldName, name)
+		})
+	}
+
+	var index []int
+	if haveIt {
+		index = field.Index
+	}
+
+	fieldIndexes.Store(key, index)
+	return index
+}
+
+// fieldByIndex returns the field of struct rv at the index sequence.
+// Returns false if a promoted field is reached through a nil embedded pointer.
+func fieldByIndex(rv reflect.Value, index []int) (reflect.Value, bool) {
+	// Walk the index sequence one field at a time, as a promoted field may be reached through a nil embedded pointer
+	for i, idx := range index {
+		if i > 0 {
+			var ok bool
+			if rv, ok = indirect(rv); !ok {
+				return rv, false
+			}
+		}
+
+		rv = rv.Field(idx)
+	}
+
+	return rv, true
+}
+
+// exactField is the fieldLookup of FieldPath and Where, which looks up a struct field by its exact name.
+// Panics if rv is not a struct, or has no field of the given name.
+func exactField(rv reflect.Value, name string) (reflect.Value, bool) {
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf(notStructErrorMsg, rv.Type(), name))
+	}
+
+	index := fieldIndex(rv.Type(), name, false)
+	if index == nil {
+		panic(fmt.Sprintf(noFieldErrorMsg, rv.Type(), name))
+	}
+
+	return fieldByIndex(rv, index)
+}
+
+// indirect dereferences pointers and interfaces until it reaches a value that is neither.
+// Returns false if a nil pointer or interface is reached.
+func indirect(rv reflect.Value) (reflect.Value, bool) {
+	for (rv.Kind() == reflect.Ptr) || (rv.Kind() == reflect.Interface) {
+		if rv.IsNil() {
+			return rv, false
+		}
+
+		rv = rv.Elem()
+	}
+
+	return rv, rv.IsValid()
+}
+
+// fieldPathValue returns the value at the path of names from val, looking up each name with lookup,
+// and dereferencing pointers and interfaces along the way.
+// Returns false if val is nil, there is a nil pointer or interface on the path, including embedded struct pointers,
+// or lookup returns false.
+func fieldPathValue(val interface{}, names []string, lookup fieldLookup) (reflect.Value, bool) {
+	rv := reflect.ValueOf(val)
+
+	for _, name := range names {
+		var ok bool
+		if rv, ok = indirect(rv); !ok {
+			return rv, false
+		}
+
+		if rv, ok = lookup(rv, name); !ok {
+			return rv, false
+		}
+	}
+
+	return rv, true
+}
+
+// fieldPathNames splits path into field names.
+// Panics if path is not a valid path of exported field names.
+func fieldPathNames(path string) []string {
+	names := strings.Split(path, ".")
+	for _, name := range names {
+		PanicBM(isExportedName(name), fmt.Sprintf(fieldPathErrorMsg, path))
+	}
+
+	return names
+}
+
+// Field (name) returns a func(interface{}) interface{} that returns the value of the named field of the arg.
+// The arg may be a struct or a pointer to a struct, and the field may be promoted from an embedded struct.
+// The func returns nil if the arg is nil, or the field is promoted through a nil embedded struct pointer.
+// The func is compatible with Map, and the field index is cached per struct type.
+// Panics if name is not a valid exported field name.
+// The func panics if the arg is not a struct, or has no field of the given name.
+func Field(name string) func(interface{}) interface{} {
+	PanicBM(isExportedName(name), fmt.Sprintf(fieldNameErrorMsg, name))
+
+	return FieldPath(name)
+}
+
+// FieldPath (path) returns a func(interface{}) interface{} that returns the value at a path of field names separated by dots, eg "Address.City".
+// Each value on the path may be a struct or a pointer to a struct, and each field may be promoted from an embedded struct.
+// The func returns nil if the arg is nil, or there is a nil pointer on the path.
+// The func is compatible with Map, and the field indexes are cached per struct type.
+// Panics if path is not a valid path of exported field names.
+// The func panics if a value on the path is not a struct, or has no field of the next name.
+func FieldPath(path string) func(interface{}) interface{} {
+	names := fieldPathNames(path)
+
+	return func(arg interface{}) interface{} {
+		rv, ok := fieldPathValue(arg, names, exactField)
+		if !ok {
+			return nil
+		}
+
+		return rv.Interface()
+	}
+}
+
+// Where (path, fn) returns a func(interface{}) bool that returns the result of fn applied to the value at the field path of the arg.
+// The path is as described by FieldPath, and fn is adapted by Filter, so it may be any func(any) bool that accepts the field type.
+// The func returns false if the arg is nil, or there is a nil pointer on the path.
+// Panics if path is not a valid path of exported field names, or fn is not a valid filter.
+// The func panics if a value on the path is not a struct, or has no field of the next name.
+func Where(path string, fn interface{}) func(interface{}) bool {
+	names := fieldPathNames(path)
+
+	return newTraceNode(fmt.Sprintf("Where(%q)", path), []interface{}{fn}, func(arg interface{}, adaptedFns []func(interface{}) bool) bool {
+		rv, ok := fieldPathValue(arg, names, exactField)
+		return ok && adaptedFns[0](rv.Interface())
+	})
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fieldAudit struct {
+	Created string
+}
+
+type fieldAddress struct {
+	City string
+}
+
+type fieldPerson struct {
+	*fieldAudit
+	Name    string
+	Age     int
+	Address *fieldAddress
+	age     int
+}
+
+func TestField(t *testing.T) {
+	var (
+		al  = fieldPerson{fieldAudit: &fieldAudit{Created: "2020"}, Name: "Al", Age: 20, Address: &fieldAddress{City: "Ottawa"}}
+		bo  = &fieldPerson{Name: "Bo", Age: 15}
+		age = Field("Age")
+	)
+
+	// Structs and pointers to structs
+	assert.Equal(t, 20, age(al))
+	assert.Equal(t, 15, age(bo))
+	assert.Nil(t, age(nil))
+	assert.Nil(t, age((*fieldPerson)(nil)))
+
+	// Promoted fields, which may be through a nil embedded pointer
+	created := Field("Created")
+	assert.Equal(t, "2020", created(al))
+	assert.Nil(t, created(bo))
+
+	// Paths
+	city := FieldPath("Address.City")
+	assert.Equal(t, "Ottawa", city(al))
+	assert.Nil(t, city(bo))
+
+	// Map compatible
+	assert.Equal(t, []interface{}{"Al", "Bo"}, []interface{}{Map(Field("Name"))(al), Map(Field("Name"))(bo)})
+
+	// Construction panics
+	for _, name := range []string{"", "age", "A.B", "1A", "A-B"} {
+		func() {
+			defer func() {
+				assert.Equal(t, fmt.Sprintf(fieldNameErrorMsg, name), recover())
+			}()
+
+			Field(name)
+			assert.Fail(t, "must panic")
+		}()
+	}
+
+	for _, path := range []string{"", "A.", ".A", "A..B", "A.b"} {
+		func() {
+			defer func() {
+				assert.Equal(t, fmt.Sprintf(fieldPathErrorMsg, path), recover())
+			}()
+
+			FieldPath(path)
+			assert.Fail(t, "must panic")
+		}()
+	}
+
+	// Use panics
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(noFieldErrorMsg, "gofuncs.fieldPerson", "Height"), recover())
+		}()
+
+		Field("Height")(al)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(notStructErrorMsg, "string", "Length"), recover())
+		}()
+
+		FieldPath("Name.Length")(al)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestWhere(t *testing.T) {
+	var (
+		al = fieldPerson{Name: "Al", Age: 20, Address: &fieldAddress{City: "Ottawa"}}
+		bo = &fieldPerson{Name: "Bo", Age: 15}
+	)
+
+	adult := Where("Age", IsGreaterThan(18))
+	assert.True(t, adult(al))
+	assert.False(t, adult(bo))
+	assert.False(t, adult(nil))
+
+	// Typed funcs are adapted
+	inOttawa := Where("Address.City", func(city string) bool { return city == "Ottawa" })
+	assert.True(t, inOttawa(al))
+	assert.False(t, inOttawa(bo))
+
+	// Composable
+	assert.True(t, And(adult, Where("Name", HasPrefix("A")))(al))
+
+	// Construction panics
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(fieldPathErrorMsg, "age"), recover())
+		}()
+
+		Where("age", IsPositive)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, filterErrorMsg, recover())
+		}()
+
+		Where("Age", 1)
+		assert.Fail(t, "must panic")
+	}()
+}
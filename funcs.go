@@ -4,29 +4,138 @@ package gofuncs
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
+	"time"
 )
 
 const (
-	indexOfErrorMsg    = "slc must be a slice"
-	valueOfKeyErrorMsg = "mp must be a map"
-	filterErrorMsg     = "fn must be a non-nil function of one argument of any type that returns bool"
-	lessThanErrorMsg   = "val must be a lessable type"
-	mapErrorMsg        = "fn must be a non-nil function of one argument of any type that returns one value of any type"
-	mapToErrorMsg      = "fn must be a non-nil function of one argument of any type that returns one value convertible to type %s"
-	supplierErrorMsg   = "fn must be a non-nil function of no arguments or a single variadic argument that returns one value of any type"
-	supplierOfErrorMsg = "fn must be a non-nil function of no arguments or a single variadic argument that returns one value convertible to type %s"
-	consumerErrorMsg   = "fn must be a non-nil funciton of one argument of any type and no return values"
-	sortErrorMsg       = "fn must be a non-nil function of two arguments of the same type and return bool"
+	indexOfErrorMsg      = "slc must be a slice"
+	valueOfKeyErrorMsg   = "mp must be a map"
+	filterErrorMsg       = "fn must be a non-nil function of one argument of any type that returns bool"
+	lessThanErrorMsg     = "val must be a lessable type"
+	mapErrorMsg          = "fn must be a non-nil function of one argument of any type that returns one value of any type"
+	mapToErrorMsg        = "fn must be a non-nil function of one argument of any type that returns one value convertible to type %s"
+	supplierErrorMsg     = "fn must be a non-nil function of no arguments or a single variadic argument that returns one value of any type"
+	supplierOfErrorMsg   = "fn must be a non-nil function of no arguments or a single variadic argument that returns one value convertible to type %s"
+	consumerErrorMsg     = "fn must be a non-nil funciton of one argument of any type and no return values"
+	sortErrorMsg         = "fn must be a non-nil function of two arguments of the same type and return bool"
+	kindSortErrorMsg     = "val1 and val2 must be of kind %s"
+	kindSortFuncErrorMsg = "kind must be a lessable kind"
+	bigErrorMsg          = "%v cannot be converted to %s"
+
+	numericExactErrorMsg = "%v must be of an int, uint, or float kind"
+	numberErrorMsg       = "%v must be of an int, uint, float, or complex kind"
+	signErrorMsg         = "%v must be a time.Time, *big.Int, *big.Float, *big.Rat, or of a type that 0 can be converted to"
+
+	// unordered is the result of a compare func for values that cannot be ordered
+	unordered = 2
+
+	// minInt64Float, maxInt64Float, and maxUint64Float are -2^63, 2^63, and 2^64, which are exact as float64
+	minInt64Float  = -(1 << 63)
+	maxInt64Float  = 1 << 63
+	maxUint64Float = 1 << 64
 )
 
-// IndexOf returns the first of the following given an array or slice, index, and optional default value:
-// 1. slice[index] if the array or slice length > index
-// 2. default value if provided, converted to array or slice element type
-// 3. zero value of array or slice element type
+// CompareOption is an option that changes how LessThan and friends compare values.
+// Options are bit flags that can be combined with |.
+type CompareOption uint
+
+const (
+	// NumericExact compares args of any int, uint, or float kind by their exact numeric value,
+	// rather than converting them to the type of val first.
+	// Negative ints are less than all uints, floats are compared to large ints without rounding,
+	// and a NaN cannot be ordered, so it is neither less than, equal to, nor greater than any value.
+	// NumericExact only applies when val is of an int, uint, or float kind.
+	NumericExact CompareOption = 1 << iota
+
+	// NaNFirst orders a float NaN before all other values, and equal to another NaN.
+	// Combined with SignedZero, floats have a total order, so they can be sorted.
+	NaNFirst
+
+	// NaNLast orders a float NaN after all other values, and equal to another NaN.
+	// If both NaNFirst and NaNLast are given, NaNFirst is used.
+	NaNLast
+
+	// SignedZero orders a float -0 before +0, rather than treating them as equal.
+	SignedZero
+
+	// CaseInsensitive compares strings using Unicode simple case folding, so "a" == "A".
+	CaseInsensitive
+
+	// IgnoreMarks compares strings ignoring Unicode nonspacing marks (category Mn), so "e\u0301" == "e".
+	// Precomposed letters of the Latin-1 Supplement and Latin Extended-A blocks are decomposed first, so "\u00e9" == "e".
+	// Precomposed letters of other blocks, such as Vietnamese letters, are not affected.
+	IgnoreMarks
+
+	// NaturalOrder compares runs of ASCII digits in strings by numeric value, so "file2" < "file10".
+	// Runs of equal value with different leading zeroes are ordered rune by rune, so "file01" < "file1".
+	NaturalOrder
+)
+
+var (
+	// timeTyp is the reflect.Type of time.Time
+	timeTyp = reflect.TypeOf(time.Time{})
+
+	// kindZeros maps each lessable kind to the zero value of the builtin type of that kind
+	kindZeros = map[reflect.Kind]interface{}{
+		reflect.Int:     0,
+		reflect.Int8:    int8(0),
+		reflect.Int16:   int16(0),
+		reflect.Int32:   int32(0),
+		reflect.Int64:   int64(0),
+		reflect.Uint:    uint(0),
+		reflect.Uint8:   uint8(0),
+		reflect.Uint16:  uint16(0),
+		reflect.Uint32:  uint32(0),
+		reflect.Uint64:  uint64(0),
+		reflect.Uintptr: uintptr(0),
+		reflect.Float32: float32(0),
+		reflect.Float64: 0.0,
+		reflect.String:  "",
+	}
+
+	// kindSortFuncs maps each lessable kind to its sort func
+	kindSortFuncs = map[reflect.Kind]func(val1, val2 interface{}) bool{
+		reflect.Int:     IntSortFunc,
+		reflect.Int8:    Int8SortFunc,
+		reflect.Int16:   Int16SortFunc,
+		reflect.Int32:   Int32SortFunc,
+		reflect.Int64:   Int64SortFunc,
+		reflect.Uint:    UintSortFunc,
+		reflect.Uint8:   Uint8SortFunc,
+		reflect.Uint16:  Uint16SortFunc,
+		reflect.Uint32:  Uint32SortFunc,
+		reflect.Uint64:  Uint64SortFunc,
+		reflect.Uintptr: UintptrSortFunc,
+		reflect.Float32: Float32SortFunc,
+		reflect.Float64: FloatSortFunc,
+		reflect.String:  StringSortFunc,
+	}
+
+	// kindSortFuncsDesc maps each lessable kind to its descending sort func
+	kindSortFuncsDesc = map[reflect.Kind]func(val1, val2 interface{}) bool{
+		reflect.Int:     IntSortFuncDesc,
+		reflect.Int8:    Int8SortFuncDesc,
+		reflect.Int16:   Int16SortFuncDesc,
+		reflect.Int32:   Int32SortFuncDesc,
+		reflect.Int64:   Int64SortFuncDesc,
+		reflect.Uint:    UintSortFuncDesc,
+		reflect.Uint8:   Uint8SortFuncDesc,
+		reflect.Uint16:  Uint16SortFuncDesc,
+		reflect.Uint32:  Uint32SortFuncDesc,
+		reflect.Uint64:  Uint64SortFuncDesc,
+		reflect.Uintptr: UintptrSortFuncDesc,
+		reflect.Float32: Float32SortFuncDesc,
+		reflect.Float64: FloatSortFuncDesc,
+		reflect.String:  StringSortFuncDesc,
+	}
+)
+
+// arrayOrSlice returns the reflect.Value of an array or slice.
 // Panics if arrslc is not an array or slice.
-// Panics if the default value is not convertible to the array or slice element type, even if it is not needed.
-func IndexOf(arrslc interface{}, index uint, defalt ...interface{}) interface{} {
+func arrayOrSlice(arrslc interface{}) reflect.Value {
 	rv := reflect.ValueOf(arrslc)
 	switch rv.Kind() {
 	case reflect.Array:
@@ -35,7 +144,20 @@ func IndexOf(arrslc interface{}, index uint, defalt ...interface{}) interface{}
 		panic(indexOfErrorMsg)
 	}
 
-	elementTyp := rv.Type().Elem()
+	return rv
+}
+
+// IndexOf returns the first of the following given an array or slice, index, and optional default value:
+// 1. slice[index] if the array or slice length > index
+// 2. default value if provided, converted to array or slice element type
+// 3. zero value of array or slice element type
+// Panics if arrslc is not an array or slice.
+// Panics if the default value is not convertible to the array or slice element type, even if it is not needed.
+func IndexOf(arrslc interface{}, index uint, defalt ...interface{}) interface{} {
+	var (
+		rv         = arrayOrSlice(arrslc)
+		elementTyp = rv.Type().Elem()
+	)
 
 	// Always ensure if default is provided that it is convertible to slice element type
 	var rdf reflect.Value
@@ -145,9 +267,7 @@ func FilterAll(fns ...interface{}) []func(interface{}) bool {
 // And (fns) any number of func(any)bool into the conjunction of all the funcs.
 // Short-circuit logic will return false on the first function that returns false.
 func And(fns ...interface{}) func(interface{}) bool {
-	adaptedFns := FilterAll(fns...)
-
-	return func(val interface{}) bool {
+	return newTraceNode("And", fns, func(val interface{}, adaptedFns []func(interface{}) bool) bool {
 		for _, fn := range adaptedFns {
 			if !fn(val) {
 				return false
@@ -155,15 +275,13 @@ func And(fns ...interface{}) func(interface{}) bool {
 		}
 
 		return true
-	}
+	})
 }
 
 // Or (fns) any number of func(any)bool into the disjunction of all the funcs.
 // Short-circuit logic will return true on the first function that returns true.
 func Or(fns ...interface{}) func(interface{}) bool {
-	adaptedFns := FilterAll(fns...)
-
-	return func(val interface{}) bool {
+	return newTraceNode("Or", fns, func(val interface{}, adaptedFns []func(interface{}) bool) bool {
 		for _, fn := range adaptedFns {
 			if fn(val) {
 				return true
@@ -171,28 +289,173 @@ func Or(fns ...interface{}) func(interface{}) bool {
 		}
 
 		return false
-	}
+	})
 }
 
 // Not (fn) adapts a func(any) bool to the negation of the func.
 func Not(fn interface{}) func(interface{}) bool {
-	adaptedFn := Filter(fn)
+	return newTraceNode("Not", []interface{}{fn}, func(val interface{}, adaptedFns []func(interface{}) bool) bool {
+		return !adaptedFns[0](val)
+	})
+}
 
-	return func(val interface{}) bool {
-		return !adaptedFn(val)
-	}
+// Xor (fns) adapts any number of func(any) bool into a func that returns true if an odd number of the funcs return true.
+// Every func must be called to know the result, so there is no short-circuit logic.
+func Xor(fns ...interface{}) func(interface{}) bool {
+	return newTraceNode("Xor", fns, func(val interface{}, adaptedFns []func(interface{}) bool) bool {
+		res := false
+		for _, fn := range adaptedFns {
+			res = res != fn(val)
+		}
+
+		return res
+	})
+}
+
+// Nand (fns) adapts any number of func(any) bool into the negation of the conjunction of all the funcs.
+// Short-circuit logic will return true on the first function that returns false.
+func Nand(fns ...interface{}) func(interface{}) bool {
+	return newTraceNode("Nand", fns, func(val interface{}, adaptedFns []func(interface{}) bool) bool {
+		for _, fn := range adaptedFns {
+			if !fn(val) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// Nor (fns) adapts any number of func(any) bool into the negation of the disjunction of all the funcs.
+// Short-circuit logic will return false on the first function that returns true.
+func Nor(fns ...interface{}) func(interface{}) bool {
+	return newTraceNode("Nor", fns, func(val interface{}, adaptedFns []func(interface{}) bool) bool {
+		for _, fn := range adaptedFns {
+			if fn(val) {
+				return false
+			}
+		}
+
+		return true
+	})
 }
 
-// EqualTo (val) returns a func(interface{}) bool that returns true if the func arg is equal to val.
+// Implies (fn1, fn2) adapts two func(any) bool into a func that returns true if fn1 returns false or fn2 returns true.
+// Short-circuit logic will return true without calling fn2 if fn1 returns false.
+func Implies(fn1, fn2 interface{}) func(interface{}) bool {
+	return newTraceNode("Implies", []interface{}{fn1, fn2}, func(val interface{}, adaptedFns []func(interface{}) bool) bool {
+		return !adaptedFns[0](val) || adaptedFns[1](val)
+	})
+}
+
+// countTrue adapts any number of func(any) bool into a func named name that counts how many of the funcs return true.
+// After each func is called, decide is called with the number of funcs that returned true and the number of funcs remaining,
+// and returns the result and true if the result is certain, or false if more funcs must be called.
+func countTrue(name string, fns []interface{}, decide func(count, remaining int) (bool, bool)) func(interface{}) bool {
+	return newTraceNode(name, fns, func(val interface{}, adaptedFns []func(interface{}) bool) bool {
+		count, remaining := 0, len(adaptedFns)
+		if res, certain := decide(count, remaining); certain {
+			return res
+		}
+
+		for _, fn := range adaptedFns {
+			if remaining--; fn(val) {
+				count++
+			}
+
+			if res, certain := decide(count, remaining); certain {
+				return res
+			}
+		}
+
+		// decide must be certain when there are no funcs remaining
+		return false
+	})
+}
+
+// AtLeast (n, fns) adapts any number of func(any) bool into a func that returns true if at least n of the funcs return true.
+// Short-circuit logic will return true as soon as n funcs return true, or false as soon as too few funcs remain to reach n.
+func AtLeast(n uint, fns ...interface{}) func(interface{}) bool {
+	least := int(n)
+
+	return countTrue(fmt.Sprintf("AtLeast(%d)", n), fns, func(count, remaining int) (bool, bool) {
+		switch {
+		case count >= least:
+			return true, true
+		case count+remaining < least:
+			return false, true
+		}
+
+		return false, false
+	})
+}
+
+// AtMost (n, fns) adapts any number of func(any) bool into a func that returns true if at most n of the funcs return true.
+// Short-circuit logic will return false as soon as more than n funcs return true, or true as soon as too few funcs remain to exceed n.
+func AtMost(n uint, fns ...interface{}) func(interface{}) bool {
+	most := int(n)
+
+	return countTrue(fmt.Sprintf("AtMost(%d)", n), fns, func(count, remaining int) (bool, bool) {
+		switch {
+		case count > most:
+			return false, true
+		case count+remaining <= most:
+			return true, true
+		}
+
+		return false, false
+	})
+}
+
+// Exactly (n, fns) adapts any number of func(any) bool into a func that returns true if exactly n of the funcs return true.
+// Short-circuit logic will return false as soon as more than n funcs return true, or too few funcs remain to reach n.
+func Exactly(n uint, fns ...interface{}) func(interface{}) bool {
+	exact := int(n)
+
+	return countTrue(fmt.Sprintf("Exactly(%d)", n), fns, func(count, remaining int) (bool, bool) {
+		switch {
+		case (count > exact) || (count+remaining < exact):
+			return false, true
+		case remaining == 0:
+			return true, true
+		}
+
+		return false, false
+	})
+}
+
+// EqualityMode chooses how EqualTo compares slices, maps, and funcs, which cannot be compared using ==
+type EqualityMode uint
+
+const (
+	// Identity compares slices, maps, and funcs by identity, which is the default.
+	// Slices are equal if they have the same backing array pointer and length,
+	// maps are equal if they are the same map, and funcs are equal if they have the same code pointer.
+	// Closures of the same func literal have the same code pointer, so they are equal even if they capture different variables.
+	Identity EqualityMode = iota
+
+	// ElementWise compares slices by length and elements, and maps by length, keys, and values.
+	// Slices and maps that contain themselves are compared without infinite recursion, the same as DeepEqualTo.
+	// Funcs are still compared by identity, as they have no elements.
+	ElementWise
+)
+
+// EqualTo (val, mode) returns a func(interface{}) bool that returns true if the func arg is equal to val.
 // The arg is converted to the type of val first, then compared.
 // If val is nil, then the arg type must be convertible to the type of val.
 // If val is an untyped nil, then the arg must be an untyped nil.
-// Comparison is made using == operator.
-// If val is not comparable using == (eg, slices are not comparable), the result will be true if val and arg have the same address.
-func EqualTo(val interface{}) func(interface{}) bool {
+// Comparison is made the same way as the == operator, without ever panicking on values that cannot be compared using ==.
+// Slices, maps, and funcs are compared according to the mode, which defaults to Identity.
+// Structs, arrays, and interfaces are compared by their fields, elements, and dynamic values, so they may contain slices, maps, and funcs.
+// Unlike DeepEqualTo, pointers are always compared by address.
+func EqualTo(val interface{}, mode ...EqualityMode) func(interface{}) bool {
 	var (
 		valIsNil = IsNil(val)
 		valTyp   = reflect.TypeOf(val)
+		eq       = deepEqualer{
+			pointerIdentity:    true,
+			collectionIdentity: (len(mode) == 0) || (mode[0] != ElementWise),
+		}
 	)
 
 	return func(arg interface{}) bool {
@@ -213,13 +476,8 @@ func EqualTo(val interface{}) func(interface{}) bool {
 			return IsNil(arg)
 		}
 
-		if !valTyp.Comparable() {
-			// val cannot be compared using ==
-			return fmt.Sprintf("%p", val) == fmt.Sprintf("%p", arg)
-		}
-
 		// val is non-nil, and arg is a possibly nil value of a convertible type
-		return (!IsNil(arg)) && (val == reflect.ValueOf(arg).Convert(valTyp).Interface())
+		return (!IsNil(arg)) && eq.equal(val, reflect.ValueOf(arg).Convert(valTyp).Interface())
 	}
 }
 
@@ -229,10 +487,13 @@ func EqualTo(val interface{}) func(interface{}) bool {
 // If val is an untyped nil, then the arg must be an untyped nil.
 // Comparison is made using reflect.DeepEqual.
 func DeepEqualTo(val interface{}) func(interface{}) bool {
-	var (
-		valIsNil = IsNil(val)
-		valTyp   = reflect.TypeOf(val)
-	)
+	return deepEqualTo(val, reflect.DeepEqual)
+}
+
+// deepEqualTo (val, eq) returns a func(interface{}) bool that handles untyped nils and conversion as described by DeepEqualTo,
+// and compares val and the converted arg using eq, which decides if typed nils are equal to non-nil values.
+func deepEqualTo(val interface{}, eq func(val1, val2 interface{}) bool) func(interface{}) bool {
+	valTyp := reflect.TypeOf(val)
 
 	return func(arg interface{}) bool {
 		argTyp := reflect.TypeOf(arg)
@@ -247,209 +508,660 @@ func DeepEqualTo(val interface{}) func(interface{}) bool {
 			return false
 		}
 
-		if valIsNil {
-			// val is a typed nil, and arg is a convertible type
-			return IsNil(arg)
-		}
-
-		// val is non-nil, and arg is a possibly nil value of a convertible type
-		return (!IsNil(arg)) && reflect.DeepEqual(val, reflect.ValueOf(arg).Convert(valTyp).Interface())
+		// val and the converted arg are of the same type, and either may be a typed nil
+		return eq(val, reflect.ValueOf(arg).Convert(valTyp).Interface())
 	}
 }
 
+// Comparable is implemented by types that define their own ordering, so they can be used with LessThan and friends.
+// Compare returns < 0 if the receiver < other, 0 if the receiver == other, and > 0 if the receiver > other.
+// other is always of the same type as the receiver.
+type Comparable interface {
+	Compare(other interface{}) int
+}
+
+// Lesser is implemented by types that define their own ordering, so they can be used with LessThan and friends.
+// Less returns true if the receiver < other.
+// other is always of the same type as the receiver.
+type Lesser interface {
+	Less(other interface{}) bool
+}
+
 // IsLessableKind returns if if kind represents any numeric type or string
 func IsLessableKind(kind reflect.Kind) bool {
 	return ((kind >= reflect.Int) && (kind <= reflect.Float64) ||
 		(kind == reflect.String))
 }
 
-// LessThan (val) returns a func(val1, val2 interface{}) bool that returns true if val1 < val2.
-// The args are converted to the type of val first, then compared.
-// Panics if val is nil or IsLessableKind(kind of val) is false.
-func LessThan(val interface{}) func(val1, val2 interface{}) bool {
+// IsLessable returns true if val is a non-nil value that LessThan accepts.
+// Returns true if IsLessableKind(kind of val) is true, or val is a Comparable, Lesser, time.Time, *big.Int, *big.Float, or *big.Rat.
+func IsLessable(val interface{}) bool {
 	if IsNil(val) {
+		return false
+	}
+
+	switch val.(type) {
+	case Comparable, Lesser, time.Time, *big.Int, *big.Float, *big.Rat:
+		return true
+	}
+
+	return IsLessableKind(reflect.ValueOf(val).Kind())
+}
+
+// compareFunc (val, opts) returns a func(val1, val2 interface{}) int that returns -1 if val1 < val2, 0 if val1 == val2, 1 if val1 > val2,
+// or unordered if val1 and val2 cannot be ordered (eg, a float NaN).
+// The args are converted to the type of val first, then compared, as described by LessThan.
+// Panics if IsLessable(val) is false.
+func compareFunc(val interface{}, opts ...CompareOption) func(val1, val2 interface{}) int {
+	if !IsLessable(val) {
 		panic(lessThanErrorMsg)
 	}
 
+	opt := compareOptions(opts)
+
+	switch val.(type) {
+	case Comparable:
+		typ := reflect.TypeOf(val)
+		return func(val1, val2 interface{}) int {
+			var (
+				c1 = reflect.ValueOf(val1).Convert(typ).Interface().(Comparable)
+				c2 = reflect.ValueOf(val2).Convert(typ).Interface()
+			)
+
+			switch res := c1.Compare(c2); {
+			case res < 0:
+				return -1
+			case res > 0:
+				return 1
+			}
+
+			return 0
+		}
+
+	case Lesser:
+		typ := reflect.TypeOf(val)
+		return func(val1, val2 interface{}) int {
+			var (
+				l1 = reflect.ValueOf(val1).Convert(typ).Interface().(Lesser)
+				l2 = reflect.ValueOf(val2).Convert(typ).Interface().(Lesser)
+			)
+
+			switch {
+			case l1.Less(l2):
+				return -1
+			case l2.Less(l1):
+				return 1
+			}
+
+			return 0
+		}
+
+	case time.Time:
+		return func(val1, val2 interface{}) int {
+			var (
+				t1 = reflect.ValueOf(val1).Convert(timeTyp).Interface().(time.Time)
+				t2 = reflect.ValueOf(val2).Convert(timeTyp).Interface().(time.Time)
+			)
+
+			switch {
+			case t1.Before(t2):
+				return -1
+			case t1.After(t2):
+				return 1
+			}
+
+			return 0
+		}
+
+	case *big.Int:
+		return func(val1, val2 interface{}) int {
+			return toBigInt(val1).Cmp(toBigInt(val2))
+		}
+
+	case *big.Float:
+		return func(val1, val2 interface{}) int {
+			if isNaN(val1) || isNaN(val2) {
+				return unordered
+			}
+
+			return toBigFloat(val1).Cmp(toBigFloat(val2))
+		}
+
+	case *big.Rat:
+		return func(val1, val2 interface{}) int {
+			if isNaN(val1) || isNaN(val2) {
+				return unordered
+			}
+
+			return toBigRat(val1).Cmp(toBigRat(val2))
+		}
+	}
+
 	kind := reflect.ValueOf(val).Kind()
-	if !IsLessableKind(kind) {
-		panic(lessThanErrorMsg)
+	if (opt&NumericExact != 0) && (kind != reflect.String) {
+		return floatOrder(compareNumbers, opt)
 	}
 
 	switch kind {
-	case reflect.Int:
-		fallthrough
-	case reflect.Int8:
-		fallthrough
-	case reflect.Int16:
-		fallthrough
-	case reflect.Int32:
-		fallthrough
-	case reflect.Int64:
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		typ := reflect.TypeOf(int64(0))
-		return func(val1, val2 interface{}) bool {
-			return reflect.ValueOf(val1).Convert(typ).Int() < reflect.ValueOf(val2).Convert(typ).Int()
-		}
-
-	case reflect.Uint:
-		fallthrough
-	case reflect.Uint8:
-		fallthrough
-	case reflect.Uint16:
-		fallthrough
-	case reflect.Uint32:
-		fallthrough
-	case reflect.Uint64:
+		return func(val1, val2 interface{}) int {
+			return compareInts(reflect.ValueOf(val1).Convert(typ).Int(), reflect.ValueOf(val2).Convert(typ).Int())
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 		typ := reflect.TypeOf(uint64(0))
-		return func(val1, val2 interface{}) bool {
-			return reflect.ValueOf(val1).Convert(typ).Uint() < reflect.ValueOf(val2).Convert(typ).Uint()
+		return func(val1, val2 interface{}) int {
+			return compareUints(reflect.ValueOf(val1).Convert(typ).Uint(), reflect.ValueOf(val2).Convert(typ).Uint())
 		}
 
-	case reflect.Float32:
-		fallthrough
-	case reflect.Float64:
+	case reflect.Float32, reflect.Float64:
 		typ := reflect.TypeOf(float64(0.0))
-		return func(val1, val2 interface{}) bool {
-			return reflect.ValueOf(val1).Convert(typ).Float() < reflect.ValueOf(val2).Convert(typ).Float()
-		}
+		return floatOrder(func(val1, val2 interface{}) int {
+			return compareFloats(reflect.ValueOf(val1).Convert(typ).Float(), reflect.ValueOf(val2).Convert(typ).Float())
+		}, opt)
 
 	// Must be string
 	default:
-		typ := reflect.TypeOf("")
-		return func(val1, val2 interface{}) bool {
-			return fmt.Sprintf("%s", reflect.ValueOf(val1).Convert(typ)) < fmt.Sprintf("%s", reflect.ValueOf(val2).Convert(typ))
+		if opt&(CaseInsensitive|IgnoreMarks|NaturalOrder) != 0 {
+			return func(val1, val2 interface{}) int {
+				return compareStrings(toString(val1), toString(val2), opt)
+			}
+		}
+
+		return func(val1, val2 interface{}) int {
+			s1, s2 := toString(val1), toString(val2)
+			switch {
+			case s1 < s2:
+				return -1
+			case s1 > s2:
+				return 1
+			}
+
+			return 0
 		}
 	}
 }
 
-// IsLessThan returns a func(arg interface{}) bool that returns true if arg < val
-func IsLessThan(val interface{}) func(interface{}) bool {
-	lt := LessThan(val)
+// isNaN returns true if val is of a float kind and is a NaN, which cannot be converted to a *big.Float or *big.Rat
+func isNaN(val interface{}) bool {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return math.IsNaN(rv.Float())
+	}
 
-	return func(arg interface{}) bool {
-		return lt(arg, val)
+	return false
+}
+
+// toBigInt converts val to a *big.Int.
+// val may be a *big.Int or any int or uint kind.
+func toBigInt(val interface{}) *big.Int {
+	if b, isa := val.(*big.Int); isa {
+		return b
 	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(rv.Uint())
+	}
+
+	panic(fmt.Sprintf(bigErrorMsg, val, "*big.Int"))
 }
 
-// LessThanEquals (val) returns a func(val1, val2 interface{}) bool that returns true if val1 <= val2.
-// The args are converted to the type of val first, then compared.
-// Panics if val is nil or IsLessableKind(kind of val) is false.
-func LessThanEquals(val interface{}) func(val1, val2 interface{}) bool {
-	if IsNil(val) {
-		panic(lessThanErrorMsg)
+// toBigFloat converts val to a *big.Float.
+// val may be a *big.Float, *big.Int, *big.Rat, or any int, uint, or float kind.
+func toBigFloat(val interface{}) *big.Float {
+	switch b := val.(type) {
+	case *big.Float:
+		return b
+	case *big.Int:
+		return new(big.Float).SetInt(b)
+	case *big.Rat:
+		return new(big.Float).SetRat(b)
 	}
 
-	kind := reflect.ValueOf(val).Kind()
-	if !IsLessableKind(kind) {
-		panic(lessThanErrorMsg)
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return big.NewFloat(rv.Float())
 	}
 
-	switch kind {
-	case reflect.Int:
-		fallthrough
-	case reflect.Int8:
-		fallthrough
-	case reflect.Int16:
-		fallthrough
-	case reflect.Int32:
-		fallthrough
-	case reflect.Int64:
-		typ := reflect.TypeOf(int64(0))
-		return func(val1, val2 interface{}) bool {
-			return reflect.ValueOf(val1).Convert(typ).Int() <= reflect.ValueOf(val2).Convert(typ).Int()
-		}
-
-	case reflect.Uint:
-		fallthrough
-	case reflect.Uint8:
-		fallthrough
-	case reflect.Uint16:
-		fallthrough
-	case reflect.Uint32:
-		fallthrough
-	case reflect.Uint64:
-		typ := reflect.TypeOf(uint64(0))
-		return func(val1, val2 interface{}) bool {
-			return reflect.ValueOf(val1).Convert(typ).Uint() <= reflect.ValueOf(val2).Convert(typ).Uint()
+	panic(fmt.Sprintf(bigErrorMsg, val, "*big.Float"))
+}
+
+// toBigRat converts val to a *big.Rat.
+// val may be a *big.Rat, *big.Int, or any int, uint, or finite float kind.
+func toBigRat(val interface{}) *big.Rat {
+	switch b := val.(type) {
+	case *big.Rat:
+		return b
+	case *big.Int:
+		return new(big.Rat).SetInt(b)
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(rv.Uint()))
+	case reflect.Float32, reflect.Float64:
+		if r := new(big.Rat).SetFloat64(rv.Float()); r != nil {
+			return r
 		}
+	}
 
-	case reflect.Float32:
-		fallthrough
-	case reflect.Float64:
-		typ := reflect.TypeOf(float64(0.0))
-		return func(val1, val2 interface{}) bool {
-			return reflect.ValueOf(val1).Convert(typ).Float() <= reflect.ValueOf(val2).Convert(typ).Float()
+	panic(fmt.Sprintf(bigErrorMsg, val, "*big.Rat"))
+}
+
+// floatOrder wraps cmp to apply the NaNFirst, NaNLast, and SignedZero options to float args.
+// If none of the options are set, cmp is returned as is.
+func floatOrder(cmp func(val1, val2 interface{}) int, opt CompareOption) func(val1, val2 interface{}) int {
+	var (
+		nanOrder   int
+		signedZero = opt&SignedZero != 0
+	)
+
+	switch {
+	case opt&NaNFirst != 0:
+		nanOrder = -1
+	case opt&NaNLast != 0:
+		nanOrder = 1
+	}
+
+	if (nanOrder == 0) && !signedZero {
+		return cmp
+	}
+
+	return func(val1, val2 interface{}) int {
+		if nanOrder != 0 {
+			switch nan1, nan2 := IsNaN(val1), IsNaN(val2); {
+			case nan1 && nan2:
+				return 0
+			case nan1:
+				return nanOrder
+			case nan2:
+				return -nanOrder
+			}
 		}
 
-	// Must be string
+		res := cmp(val1, val2)
+		if (res == 0) && signedZero {
+			switch neg1, neg2 := isNegativeZero(val1), isNegativeZero(val2); {
+			case neg1 && !neg2:
+				return -1
+			case neg2 && !neg1:
+				return 1
+			}
+		}
+
+		return res
+	}
+}
+
+// isNegativeZero returns true if val is a float -0
+func isNegativeZero(val interface{}) bool {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return (f == 0) && math.Signbit(f)
+	}
+
+	return false
+}
+
+// compareOptions combines all the given options into one
+func compareOptions(opts []CompareOption) CompareOption {
+	var opt CompareOption
+	for _, o := range opts {
+		opt |= o
+	}
+
+	return opt
+}
+
+// numberClass returns reflect.Int, reflect.Uint, or reflect.Float64 for a value of any int, uint, or float kind.
+// Panics if val is not of any int, uint, or float kind.
+func numberClass(val interface{}) (reflect.Value, reflect.Kind) {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv, reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv, reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return rv, reflect.Float64
+	}
+
+	panic(fmt.Sprintf(numericExactErrorMsg, val))
+}
+
+// compareNumbers compares two values of any int, uint, or float kind by their exact numeric value.
+// Returns -1, 0, or 1, or unordered if either value is a NaN.
+// Panics if either value is not of any int, uint, or float kind.
+func compareNumbers(val1, val2 interface{}) int {
+	var (
+		rv1, class1 = numberClass(val1)
+		rv2, class2 = numberClass(val2)
+	)
+
+	switch {
+	case (class1 == reflect.Int) && (class2 == reflect.Int):
+		return compareInts(rv1.Int(), rv2.Int())
+	case (class1 == reflect.Uint) && (class2 == reflect.Uint):
+		return compareUints(rv1.Uint(), rv2.Uint())
+	case (class1 == reflect.Float64) && (class2 == reflect.Float64):
+		return compareFloats(rv1.Float(), rv2.Float())
+	case (class1 == reflect.Int) && (class2 == reflect.Uint):
+		return compareIntUint(rv1.Int(), rv2.Uint())
+	case (class1 == reflect.Uint) && (class2 == reflect.Int):
+		return -compareIntUint(rv2.Int(), rv1.Uint())
+	case class1 == reflect.Float64:
+		return compareFloatInteger(rv1.Float(), rv2)
+	}
+
+	// class2 must be float
+	return reverseCompare(compareFloatInteger(rv2.Float(), rv1))
+}
+
+// reverseCompare reverses the result of a comparison, leaving unordered as is
+func reverseCompare(res int) int {
+	if res == unordered {
+		return res
+	}
+
+	return -res
+}
+
+// compareInts compares two int64 values
+func compareInts(i1, i2 int64) int {
+	switch {
+	case i1 < i2:
+		return -1
+	case i1 > i2:
+		return 1
+	}
+
+	return 0
+}
+
+// compareUints compares two uint64 values
+func compareUints(u1, u2 uint64) int {
+	switch {
+	case u1 < u2:
+		return -1
+	case u1 > u2:
+		return 1
+	}
+
+	return 0
+}
+
+// compareFloats compares two float64 values, returning unordered if either is a NaN
+func compareFloats(f1, f2 float64) int {
+	switch {
+	case f1 < f2:
+		return -1
+	case f1 > f2:
+		return 1
+	case f1 == f2:
+		return 0
+	}
+
+	return unordered
+}
+
+// compareIntUint compares an int64 to a uint64 without overflow
+func compareIntUint(i int64, u uint64) int {
+	if i < 0 {
+		return -1
+	}
+
+	return compareUints(uint64(i), u)
+}
+
+// compareFloatInteger compares a float64 to a reflect.Value of any int or uint kind without loss of precision.
+// Returns unordered if f is a NaN.
+func compareFloatInteger(f float64, rv reflect.Value) int {
+	if math.IsNaN(f) {
+		return unordered
+	}
+
+	// The integer part of f is compared first, and if equal, the fraction decides.
+	// Floats outside the range of the integer type are less than or greater than all values of the type.
+	var (
+		trunc = math.Trunc(f)
+		res   int
+	)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case trunc < minInt64Float:
+			return -1
+		case trunc >= maxInt64Float:
+			return 1
+		}
+
+		res = compareInts(int64(trunc), rv.Int())
+
 	default:
-		typ := reflect.TypeOf("")
-		return func(val1, val2 interface{}) bool {
-			return fmt.Sprintf("%s", reflect.ValueOf(val1).Convert(typ)) <= fmt.Sprintf("%s", reflect.ValueOf(val2).Convert(typ))
+		switch {
+		case trunc < 0:
+			return -1
+		case trunc >= maxUint64Float:
+			return 1
 		}
+
+		res = compareUints(uint64(trunc), rv.Uint())
+	}
+
+	if res == 0 {
+		res = compareFloats(f-trunc, 0)
+	}
+
+	return res
+}
+
+// LessThan (val, opts) returns a func(val1, val2 interface{}) bool that returns true if val1 < val2.
+// The args are converted to the type of val first, then compared.
+// If val is a Comparable or Lesser, the args are compared using Compare or Less, which takes precedence over the kind of val.
+// If val is a time.Time, the args must be convertible to time.Time, and are compared using Before and After.
+// If val is a *big.Int, *big.Float, or *big.Rat, the args may be any big number or numeric kind the type of val can represent,
+// and are compared using Cmp.
+// If opts contains NumericExact and val is of any int, uint, or float kind, the args are compared exactly as described by NumericExact.
+// By default, a float NaN cannot be ordered, so LessThan, LessThanEquals, GreaterThan, and GreaterThanEquals all return false
+// if either arg is a NaN, and -0 is equal to +0.
+// If opts contains NaNFirst, NaNLast, or SignedZero, then float args are ordered as described by those options.
+// If opts contains CaseInsensitive, IgnoreMarks, or NaturalOrder, then string args are ordered as described by those options,
+// after decomposing precomposed Latin-1 Supplement and Latin Extended-A letters, so composed and decomposed forms are equal.
+// Panics if IsLessable(val) is false.
+func LessThan(val interface{}, opts ...CompareOption) func(val1, val2 interface{}) bool {
+	cmp := compareFunc(val, opts...)
+
+	return func(val1, val2 interface{}) bool {
+		return cmp(val1, val2) < 0
+	}
+}
+
+// IsLessThan (val, opts) returns a func(arg interface{}) bool that returns true if arg < val using LessThan
+func IsLessThan(val interface{}, opts ...CompareOption) func(interface{}) bool {
+	lt := LessThan(val, opts...)
+
+	return func(arg interface{}) bool {
+		return lt(arg, val)
+	}
+}
+
+// LessThanEquals (val, opts) returns a func(val1, val2 interface{}) bool that returns true if val1 <= val2.
+// The args are converted and compared the same way as LessThan.
+// Panics if IsLessable(val) is false.
+func LessThanEquals(val interface{}, opts ...CompareOption) func(val1, val2 interface{}) bool {
+	cmp := compareFunc(val, opts...)
+
+	return func(val1, val2 interface{}) bool {
+		return cmp(val1, val2) <= 0
 	}
 }
 
-// IsLessThanEquals returns a func(arg interface{}) bool that returns true if arg <= val
-func IsLessThanEquals(val interface{}) func(interface{}) bool {
-	lte := LessThanEquals(val)
+// IsLessThanEquals (val, opts) returns a func(arg interface{}) bool that returns true if arg <= val using LessThanEquals
+func IsLessThanEquals(val interface{}, opts ...CompareOption) func(interface{}) bool {
+	lte := LessThanEquals(val, opts...)
 
 	return func(arg interface{}) bool {
 		return lte(arg, val)
 	}
 }
 
-// GreaterThan (val) returns a func(val1, val2 interface{}) bool that returns true if val1 > val2.
-// The args are converted to the type of val first, then compared.
-// Panics if val is nil or IsLessableKind(kind of val) is false.
-func GreaterThan(val interface{}) func(val1, val2 interface{}) bool {
-	lte := LessThanEquals(val)
+// GreaterThan (val, opts) returns a func(val1, val2 interface{}) bool that returns true if val1 > val2.
+// The args are converted and compared the same way as LessThan.
+// Panics if IsLessable(val) is false.
+func GreaterThan(val interface{}, opts ...CompareOption) func(val1, val2 interface{}) bool {
+	cmp := compareFunc(val, opts...)
+
 	return func(val1, val2 interface{}) bool {
-		return !lte(val1, val2)
+		return cmp(val1, val2) == 1
 	}
 }
 
-// IsGreaterThan returns a func(arg interface{}) bool that returns true if arg > val
-func IsGreaterThan(val interface{}) func(interface{}) bool {
-	gt := GreaterThan(val)
+// IsGreaterThan (val, opts) returns a func(arg interface{}) bool that returns true if arg > val using GreaterThan
+func IsGreaterThan(val interface{}, opts ...CompareOption) func(interface{}) bool {
+	gt := GreaterThan(val, opts...)
 
 	return func(arg interface{}) bool {
 		return gt(arg, val)
 	}
 }
 
-// GreaterThanEquals (val) returns a func(val1, val2 interface{}) bool that returns true if val1 >= val2.
-// The args are converted to the type of val first, then compared.
-// Panics if val is nil or IsLessableKind(kind of val) is false.
-func GreaterThanEquals(val interface{}) func(val1, val2 interface{}) bool {
-	lt := LessThan(val)
+// GreaterThanEquals (val, opts) returns a func(val1, val2 interface{}) bool that returns true if val1 >= val2.
+// The args are converted and compared the same way as LessThan.
+// Panics if IsLessable(val) is false.
+func GreaterThanEquals(val interface{}, opts ...CompareOption) func(val1, val2 interface{}) bool {
+	cmp := compareFunc(val, opts...)
+
 	return func(val1, val2 interface{}) bool {
-		return !lt(val1, val2)
+		res := cmp(val1, val2)
+		return (res == 0) || (res == 1)
 	}
 }
 
-// IsGreaterThanEquals returns a func(arg interface{}) bool that returns true if arg >= val
-func IsGreaterThanEquals(val interface{}) func(interface{}) bool {
-	gte := GreaterThanEquals(val)
+// IsGreaterThanEquals (val, opts) returns a func(arg interface{}) bool that returns true if arg >= val using GreaterThanEquals
+func IsGreaterThanEquals(val interface{}, opts ...CompareOption) func(interface{}) bool {
+	gte := GreaterThanEquals(val, opts...)
 
 	return func(arg interface{}) bool {
 		return gte(arg, val)
 	}
 }
 
-// IsNegative (val) returns true if the val < 0
+// signZero returns the zero that IsNegative, IsNonNegative, and IsPositive compare val to,
+// which is the zero time.Time (January 1, year 1, 00:00:00 UTC) for a time.Time, else 0.
+// Panics if val is not a time.Time, *big.Int, *big.Float, *big.Rat, or of a type that 0 can be converted to.
+func signZero(val interface{}) interface{} {
+	switch val.(type) {
+	case time.Time:
+		return time.Time{}
+	case nil, *big.Int, *big.Float, *big.Rat:
+		// A nil val panics in compareFunc
+		return 0
+	}
+
+	PanicBM(reflect.TypeOf(0).ConvertibleTo(reflect.TypeOf(val)), fmt.Sprintf(signErrorMsg, val))
+	return 0
+}
+
+// IsNegative (val) returns true if the val < 0, or for a time.Time, if it is before the zero time.Time.
+// Numeric kinds are compared using NumericExact.
+// Panics if val is not lessable, or is not a time.Time, *big.Int, *big.Float, *big.Rat, or of a type that 0 can be converted to.
 func IsNegative(val interface{}) bool {
-	return LessThan(val)(val, 0)
+	zero := signZero(val)
+	return LessThan(val, NumericExact)(val, zero)
 }
 
-// IsNonNegative (val) returns true if val >= 0
+// IsNonNegative (val) returns true if val >= 0, or for a time.Time, if it is not before the zero time.Time.
+// Numeric kinds are compared using NumericExact.
+// Panics if val is not lessable, or is not a time.Time, *big.Int, *big.Float, *big.Rat, or of a type that 0 can be converted to.
 func IsNonNegative(val interface{}) bool {
-	return GreaterThanEquals(val)(val, 0)
+	zero := signZero(val)
+	return GreaterThanEquals(val, NumericExact)(val, zero)
 }
 
-// IsPositive (val) returns true if val > 0
+// IsPositive (val) returns true if val > 0, or for a time.Time, if it is after the zero time.Time.
+// Numeric kinds are compared using NumericExact.
+// Panics if val is not lessable, or is not a time.Time, *big.Int, *big.Float, *big.Rat, or of a type that 0 can be converted to.
 func IsPositive(val interface{}) bool {
-	return GreaterThan(val)(val, 0)
+	zero := signZero(val)
+	return GreaterThan(val, NumericExact)(val, zero)
+}
+
+// floatParts returns the float value(s) of val: none for an int or uint kind, one for a float kind,
+// and the real and imaginary parts for a complex kind.
+// Panics if val is not of any int, uint, float, or complex kind.
+func floatParts(val interface{}) []float64 {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return nil
+	case reflect.Float32, reflect.Float64:
+		return []float64{rv.Float()}
+	case reflect.Complex64, reflect.Complex128:
+		c := rv.Complex()
+		return []float64{real(c), imag(c)}
+	}
+
+	panic(fmt.Sprintf(numberErrorMsg, val))
+}
+
+// IsNaN is a func(interface{}) bool that returns true if val is a float NaN, or a complex with a NaN real or imaginary part.
+// Ints and uints are never NaN.
+// Panics if val is not of any int, uint, float, or complex kind.
+func IsNaN(val interface{}) bool {
+	for _, f := range floatParts(val) {
+		if math.IsNaN(f) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFinite is a func(interface{}) bool that returns true if val is neither a NaN nor an infinity.
+// A complex is finite if both the real and imaginary parts are finite, and ints and uints are always finite.
+// Panics if val is not of any int, uint, float, or complex kind.
+func IsFinite(val interface{}) bool {
+	for _, f := range floatParts(val) {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsInf (sign) returns a func(interface{}) bool that returns true if the arg is an infinity with the given sign.
+// If sign > 0, the arg must be +Inf, if sign < 0, the arg must be -Inf, and if sign == 0, the arg may be either.
+// A complex is an infinity if either the real or imaginary part is, and ints and uints are never an infinity.
+// The func panics if the arg is not of any int, uint, float, or complex kind.
+func IsInf(sign int) func(interface{}) bool {
+	return func(arg interface{}) bool {
+		for _, f := range floatParts(arg) {
+			if math.IsInf(f, sign) {
+				return true
+			}
+		}
+
+		return false
+	}
 }
 
 // IsNil is a func(interface{}) bool that returns true if val is nil
@@ -791,22 +1503,281 @@ func SortFunc(fn interface{}) func(val1, val2 interface{}) bool {
 	}
 }
 
-// IntSortFunc returns true if val1.(int) < val2.(int)
+// kindValue returns the reflect.Value of val.
+// The typed sort funcs compare values of the builtin type directly, and only use kindValue for other types of the same kind.
+// Panics if the kind of val is not the given kind.
+func kindValue(val interface{}, kind reflect.Kind) reflect.Value {
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != kind {
+		panic(fmt.Sprintf(kindSortErrorMsg, kind))
+	}
+
+	return rv
+}
+
+// NaturalSortFunc returns true if val1 < val2 according to LessThan(val1).
+// It can sort any lessable type, including Comparable and Lesser types, without writing a per-type sort func.
+func NaturalSortFunc(val1, val2 interface{}) bool {
+	return LessThan(val1)(val1, val2)
+}
+
+// IntSortFunc returns true if val1 < val2, where val1 and val2 are any type of kind int
 func IntSortFunc(val1, val2 interface{}) bool {
-	return val1.(int) < val2.(int)
+	if v1, isa := val1.(int); isa {
+		if v2, isa := val2.(int); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Int).Int() < kindValue(val2, reflect.Int).Int()
+}
+
+// Int8SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind int8
+func Int8SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(int8); isa {
+		if v2, isa := val2.(int8); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Int8).Int() < kindValue(val2, reflect.Int8).Int()
+}
+
+// Int16SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind int16
+func Int16SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(int16); isa {
+		if v2, isa := val2.(int16); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Int16).Int() < kindValue(val2, reflect.Int16).Int()
 }
 
-// UintSortFunc returns true if val1.(uint) < val2.(uint)
+// Int32SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind int32
+func Int32SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(int32); isa {
+		if v2, isa := val2.(int32); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Int32).Int() < kindValue(val2, reflect.Int32).Int()
+}
+
+// Int64SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind int64
+func Int64SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(int64); isa {
+		if v2, isa := val2.(int64); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Int64).Int() < kindValue(val2, reflect.Int64).Int()
+}
+
+// UintSortFunc returns true if val1 < val2, where val1 and val2 are any type of kind uint
 func UintSortFunc(val1, val2 interface{}) bool {
-	return val1.(uint) < val2.(uint)
+	if v1, isa := val1.(uint); isa {
+		if v2, isa := val2.(uint); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Uint).Uint() < kindValue(val2, reflect.Uint).Uint()
+}
+
+// Uint8SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind uint8
+func Uint8SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(uint8); isa {
+		if v2, isa := val2.(uint8); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Uint8).Uint() < kindValue(val2, reflect.Uint8).Uint()
+}
+
+// Uint16SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind uint16
+func Uint16SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(uint16); isa {
+		if v2, isa := val2.(uint16); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Uint16).Uint() < kindValue(val2, reflect.Uint16).Uint()
+}
+
+// Uint32SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind uint32
+func Uint32SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(uint32); isa {
+		if v2, isa := val2.(uint32); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Uint32).Uint() < kindValue(val2, reflect.Uint32).Uint()
+}
+
+// Uint64SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind uint64
+func Uint64SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(uint64); isa {
+		if v2, isa := val2.(uint64); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Uint64).Uint() < kindValue(val2, reflect.Uint64).Uint()
+}
+
+// UintptrSortFunc returns true if val1 < val2, where val1 and val2 are any type of kind uintptr
+func UintptrSortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(uintptr); isa {
+		if v2, isa := val2.(uintptr); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Uintptr).Uint() < kindValue(val2, reflect.Uintptr).Uint()
+}
+
+// Float32SortFunc returns true if val1 < val2, where val1 and val2 are any type of kind float32
+func Float32SortFunc(val1, val2 interface{}) bool {
+	if v1, isa := val1.(float32); isa {
+		if v2, isa := val2.(float32); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Float32).Float() < kindValue(val2, reflect.Float32).Float()
 }
 
-// FloatSortFunc returns true if val1.(float64) < val2.(float64)
+// FloatSortFunc returns true if val1 < val2, where val1 and val2 are any type of kind float64
 func FloatSortFunc(val1, val2 interface{}) bool {
-	return val1.(float64) < val2.(float64)
+	if v1, isa := val1.(float64); isa {
+		if v2, isa := val2.(float64); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.Float64).Float() < kindValue(val2, reflect.Float64).Float()
 }
 
-// StringSortFunc returns true if val1.(string) < val2.(string)
+// StringSortFunc returns true if val1 < val2, where val1 and val2 are any type of kind string
 func StringSortFunc(val1, val2 interface{}) bool {
-	return val1.(string) < val2.(string)
+	if v1, isa := val1.(string); isa {
+		if v2, isa := val2.(string); isa {
+			return v1 < v2
+		}
+	}
+
+	return kindValue(val1, reflect.String).String() < kindValue(val2, reflect.String).String()
+}
+
+// IntSortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind int
+func IntSortFuncDesc(val1, val2 interface{}) bool {
+	return IntSortFunc(val2, val1)
+}
+
+// Int8SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind int8
+func Int8SortFuncDesc(val1, val2 interface{}) bool {
+	return Int8SortFunc(val2, val1)
+}
+
+// Int16SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind int16
+func Int16SortFuncDesc(val1, val2 interface{}) bool {
+	return Int16SortFunc(val2, val1)
+}
+
+// Int32SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind int32
+func Int32SortFuncDesc(val1, val2 interface{}) bool {
+	return Int32SortFunc(val2, val1)
+}
+
+// Int64SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind int64
+func Int64SortFuncDesc(val1, val2 interface{}) bool {
+	return Int64SortFunc(val2, val1)
+}
+
+// UintSortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind uint
+func UintSortFuncDesc(val1, val2 interface{}) bool {
+	return UintSortFunc(val2, val1)
+}
+
+// Uint8SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind uint8
+func Uint8SortFuncDesc(val1, val2 interface{}) bool {
+	return Uint8SortFunc(val2, val1)
+}
+
+// Uint16SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind uint16
+func Uint16SortFuncDesc(val1, val2 interface{}) bool {
+	return Uint16SortFunc(val2, val1)
+}
+
+// Uint32SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind uint32
+func Uint32SortFuncDesc(val1, val2 interface{}) bool {
+	return Uint32SortFunc(val2, val1)
+}
+
+// Uint64SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind uint64
+func Uint64SortFuncDesc(val1, val2 interface{}) bool {
+	return Uint64SortFunc(val2, val1)
+}
+
+// UintptrSortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind uintptr
+func UintptrSortFuncDesc(val1, val2 interface{}) bool {
+	return UintptrSortFunc(val2, val1)
+}
+
+// Float32SortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind float32
+func Float32SortFuncDesc(val1, val2 interface{}) bool {
+	return Float32SortFunc(val2, val1)
+}
+
+// FloatSortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind float64
+func FloatSortFuncDesc(val1, val2 interface{}) bool {
+	return FloatSortFunc(val2, val1)
+}
+
+// StringSortFuncDesc returns true if val1 > val2, where val1 and val2 are any type of kind string
+func StringSortFuncDesc(val1, val2 interface{}) bool {
+	return StringSortFunc(val2, val1)
+}
+
+// KindSortFunc (kind, opts) returns the sort func for the given kind, eg KindSortFunc(reflect.Int8) returns Int8SortFunc.
+// If any opts are given, the result compares values of the given kind using LessThan with the opts,
+// eg KindSortFunc(reflect.Float64, NaNFirst) sorts NaNs before all other floats.
+// Panics if IsLessableKind(kind) is false.
+func KindSortFunc(kind reflect.Kind, opts ...CompareOption) func(val1, val2 interface{}) bool {
+	fn, haveIt := kindSortFuncs[kind]
+	PanicBM(haveIt, kindSortFuncErrorMsg)
+
+	if len(opts) == 0 {
+		return fn
+	}
+
+	lt := LessThan(kindZeros[kind], opts...)
+
+	return func(val1, val2 interface{}) bool {
+		return lt(kindValue(val1, kind).Interface(), kindValue(val2, kind).Interface())
+	}
+}
+
+// KindSortFuncDesc (kind, opts) returns the descending sort func for the given kind, eg KindSortFuncDesc(reflect.Int8) returns Int8SortFuncDesc.
+// If any opts are given, they are applied as described by KindSortFunc.
+// Panics if IsLessableKind(kind) is false.
+func KindSortFuncDesc(kind reflect.Kind, opts ...CompareOption) func(val1, val2 interface{}) bool {
+	if len(opts) == 0 {
+		fn, haveIt := kindSortFuncsDesc[kind]
+		PanicBM(haveIt, kindSortFuncErrorMsg)
+
+		return fn
+	}
+
+	fn := KindSortFunc(kind, opts...)
+
+	return func(val1, val2 interface{}) bool {
+		return fn(val2, val1)
+	}
 }
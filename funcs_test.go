@@ -3,11 +3,15 @@
 package gofuncs
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
+	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -275,6 +279,169 @@ func TestFilter(t *testing.T) {
 	}()
 }
 
+func TestCombinators(t *testing.T) {
+	// calls records which funcs are called, so short-circuiting can be checked
+	var calls []int
+	fn := func(i int, res bool) func(interface{}) bool {
+		return func(interface{}) bool {
+			calls = append(calls, i)
+			return res
+		}
+	}
+
+	check := func(combined func(interface{}) bool, expected bool, expectedCalls []int) {
+		calls = nil
+		assert.Equal(t, expected, combined(0))
+		assert.Equal(t, expectedCalls, calls)
+	}
+
+	// Xor is odd parity, and calls every func
+	check(Xor(), false, nil)
+	check(Xor(fn(0, true)), true, []int{0})
+	check(Xor(fn(0, true), fn(1, true)), false, []int{0, 1})
+	check(Xor(fn(0, true), fn(1, false), fn(2, true), fn(3, true)), true, []int{0, 1, 2, 3})
+
+	// Nand and Nor
+	check(Nand(fn(0, true), fn(1, false), fn(2, true)), true, []int{0, 1})
+	check(Nand(fn(0, true), fn(1, true)), false, []int{0, 1})
+	check(Nor(fn(0, false), fn(1, true), fn(2, false)), false, []int{0, 1})
+	check(Nor(fn(0, false), fn(1, false)), true, []int{0, 1})
+
+	// Implies
+	check(Implies(fn(0, false), fn(1, false)), true, []int{0})
+	check(Implies(fn(0, true), fn(1, false)), false, []int{0, 1})
+	check(Implies(fn(0, true), fn(1, true)), true, []int{0, 1})
+
+	// AtLeast
+	check(AtLeast(0), true, nil)
+	check(AtLeast(1), false, nil)
+	check(AtLeast(2, fn(0, true), fn(1, true), fn(2, true)), true, []int{0, 1})
+	check(AtLeast(2, fn(0, false), fn(1, false), fn(2, true)), false, []int{0, 1})
+	check(AtLeast(2, fn(0, false), fn(1, true), fn(2, true)), true, []int{0, 1, 2})
+	check(AtLeast(4, fn(0, true), fn(1, true), fn(2, true)), false, nil)
+
+	// AtMost
+	check(AtMost(0), true, nil)
+	check(AtMost(1, fn(0, true), fn(1, true), fn(2, false)), false, []int{0, 1})
+	check(AtMost(1, fn(0, false), fn(1, false), fn(2, true)), true, []int{0, 1})
+	check(AtMost(1, fn(0, true), fn(1, false), fn(2, false)), true, []int{0, 1, 2})
+	check(AtMost(3, fn(0, true), fn(1, true), fn(2, true)), true, nil)
+
+	// Exactly
+	check(Exactly(0), true, nil)
+	check(Exactly(1), false, nil)
+	check(Exactly(1, fn(0, true), fn(1, true), fn(2, false)), false, []int{0, 1})
+	check(Exactly(2, fn(0, false), fn(1, false), fn(2, true)), false, []int{0, 1})
+	check(Exactly(1, fn(0, true), fn(1, false), fn(2, false)), true, []int{0, 1, 2})
+	check(Exactly(0, fn(0, false), fn(1, false)), true, []int{0, 1})
+	check(Exactly(3, fn(0, true), fn(1, true)), false, nil)
+
+	// Funcs are adapted
+	between := AtLeast(2, func(i int) bool { return i > 0 }, func(i int8) bool { return i < 10 }, IsNegative)
+	assert.True(t, between(5))
+	assert.True(t, between(-5))
+	assert.False(t, between(20))
+	assert.True(t, Exactly(1, IsNegative, IsPositive)(3))
+	assert.False(t, Exactly(1, IsNegative, IsPositive)(0))
+
+	func() {
+		defer func() {
+			assert.Equal(t, filterErrorMsg, recover())
+		}()
+
+		Implies(IsNegative, 1)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestEqualToNonComparable(t *testing.T) {
+	// Slices
+	var (
+		slc = []int{1, 2, 3}
+		fn  = EqualTo(slc)
+	)
+	assert.True(t, fn(slc))
+	assert.False(t, fn(slc[:2]))
+	assert.False(t, fn([]int{1, 2, 3}))
+	assert.True(t, EqualTo(slc, ElementWise)([]int{1, 2, 3}))
+	assert.False(t, EqualTo(slc, ElementWise)([]int{1, 2}))
+	assert.False(t, EqualTo(slc, ElementWise)([]int(nil)))
+
+	// Maps
+	mp := map[string]int{"a": 1}
+	assert.True(t, EqualTo(mp)(mp))
+	assert.False(t, EqualTo(mp)(map[string]int{"a": 1}))
+	assert.True(t, EqualTo(mp, ElementWise)(map[string]int{"a": 1}))
+	assert.False(t, EqualTo(mp, ElementWise)(map[string]int{"a": 2}))
+	assert.False(t, EqualTo(mp, ElementWise)(map[string]int{"b": 1}))
+
+	// Funcs are always compared by identity
+	assert.True(t, EqualTo(IsBlank, ElementWise)(IsBlank))
+	assert.False(t, EqualTo(IsBlank)(IsNaN))
+
+	// Structs and arrays containing slices do not panic
+	type record struct {
+		Name string
+		Tags []string
+		Any  interface{}
+		ptr  *int
+	}
+	var (
+		tags = []string{"a"}
+		one  = 1
+		rec  = record{Name: "a", Tags: tags, Any: []int{1}, ptr: &one}
+	)
+	assert.True(t, EqualTo(rec)(rec))
+	rec.Any = tags
+	assert.True(t, EqualTo(rec)(rec))
+	assert.False(t, EqualTo(rec)(record{Name: "a", Tags: []string{"a"}, Any: tags, ptr: &one}))
+	assert.True(t, EqualTo(rec, ElementWise)(record{Name: "a", Tags: []string{"a"}, Any: []string{"a"}, ptr: &one}))
+	assert.False(t, EqualTo(rec, ElementWise)(record{Name: "b", Tags: []string{"a"}, Any: []string{"a"}, ptr: &one}))
+
+	// Pointers are compared by address
+	two := 1
+	assert.False(t, EqualTo(rec, ElementWise)(record{Name: "a", Tags: []string{"a"}, Any: []string{"a"}, ptr: &two}))
+
+	arr := [2][]int{{1}, {2}}
+	assert.False(t, EqualTo(arr)([2][]int{{1}, {2}}))
+	assert.True(t, EqualTo(arr)(arr))
+	assert.True(t, EqualTo(arr, ElementWise)([2][]int{{1}, {2}}))
+
+	// Comparable values with interfaces holding slices do not panic
+	type holder struct {
+		Val interface{}
+	}
+	assert.False(t, EqualTo(holder{slc})(holder{append([]int{}, slc...)}))
+	assert.True(t, EqualTo(holder{slc}, ElementWise)(holder{append([]int{}, slc...)}))
+	assert.True(t, EqualTo(holder{1})(holder{1}))
+	assert.False(t, EqualTo(holder{1})(holder{int64(1)}))
+	assert.False(t, EqualTo(math.NaN())(math.NaN()))
+
+	// Slices and maps that contain themselves through an interface do not recurse forever
+	cyclic := func() []interface{} {
+		s := []interface{}{1, nil}
+		s[1] = s
+		return s
+	}
+	assert.True(t, EqualTo(cyclic(), ElementWise)(cyclic()))
+	assert.False(t, EqualTo(cyclic())(cyclic()))
+
+	cyclicMap := func(i int) map[string]interface{} {
+		m := map[string]interface{}{"i": i}
+		m["self"] = m
+		return m
+	}
+	assert.True(t, EqualTo(cyclicMap(1), ElementWise)(cyclicMap(1)))
+	assert.False(t, EqualTo(cyclicMap(1), ElementWise)(cyclicMap(2)))
+
+	// Funcs are compared by code pointer, so closures of the same func literal are equal
+	closure := func(i int) func() int {
+		return func() int { return i }
+	}
+	assert.True(t, EqualTo(closure(1))(closure(2)))
+	assert.False(t, EqualTo(closure(1))(func() int { return 1 }))
+}
+
 func TestMap(t *testing.T) {
 	// Exact match
 	mapFn := Map(func(i interface{}) interface{} { return i.(int) * 2 })
@@ -666,3 +833,436 @@ func TestSortFunc(t *testing.T) {
 	assert.True(t, sf("a", "b"))
 	assert.False(t, sf("b", "a"))
 }
+
+func TestKindSortFuncs(t *testing.T) {
+	type myString string
+
+	// Every lessable kind, including named types
+	for _, vals := range [][2]interface{}{
+		{1, 2},
+		{int8(1), int8(2)},
+		{int16(1), int16(2)},
+		{int32(1), int32(2)},
+		{int64(1), int64(2)},
+		{uint(1), uint(2)},
+		{uint8(1), uint8(2)},
+		{uint16(1), uint16(2)},
+		{uint32(1), uint32(2)},
+		{uint64(1), uint64(2)},
+		{uintptr(1), uintptr(2)},
+		{float32(1), float32(2)},
+		{1.0, 2.0},
+		{"a", "b"},
+		{myString("a"), myString("b")},
+	} {
+		kind := reflect.ValueOf(vals[0]).Kind()
+
+		sf := KindSortFunc(kind)
+		assert.True(t, sf(vals[0], vals[1]))
+		assert.False(t, sf(vals[1], vals[0]))
+		assert.False(t, sf(vals[0], vals[0]))
+
+		sf = KindSortFuncDesc(kind)
+		assert.False(t, sf(vals[0], vals[1]))
+		assert.True(t, sf(vals[1], vals[0]))
+		assert.False(t, sf(vals[0], vals[0]))
+	}
+
+	sf := Int64SortFunc
+	assert.True(t, sf(time.Second, time.Minute))
+
+	sf = StringSortFuncDesc
+	assert.True(t, sf(myString("b"), "a"))
+
+	// The builtin type mixed with a named type of the same kind
+	sf = IntSortFunc
+	assert.True(t, sf(1, time.Month(2)))
+	assert.False(t, sf(time.Month(2), 1))
+
+	func() {
+		defer func() {
+			assert.Equal(t, "val1 and val2 must be of kind int8", recover())
+		}()
+
+		Int8SortFunc(1, 2)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, kindSortFuncErrorMsg, recover())
+		}()
+
+		KindSortFunc(reflect.Struct)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, kindSortFuncErrorMsg, recover())
+		}()
+
+		KindSortFuncDesc(reflect.Bool)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestLessThanStdTypes(t *testing.T) {
+	// time.Duration is an int64 kind
+	assert.True(t, IsLessThan(time.Minute)(time.Second))
+	assert.True(t, IsNegative(-time.Second))
+
+	// time.Time
+	var (
+		now   = time.Now()
+		later = now.Add(time.Hour)
+	)
+	assert.True(t, IsLessable(now))
+	assert.True(t, LessThan(now)(now, later))
+	assert.False(t, LessThan(now)(later, now))
+	assert.False(t, LessThan(now)(now, now))
+	assert.True(t, LessThanEquals(now)(now, now))
+	assert.True(t, IsGreaterThan(now)(later))
+	assert.False(t, IsGreaterThanEquals(later)(now))
+
+	// The sign of a time.Time is relative to the zero time.Time
+	assert.False(t, IsNegative(now))
+	assert.True(t, IsNonNegative(now))
+	assert.True(t, IsPositive(now))
+	assert.False(t, IsNegative(time.Time{}))
+	assert.True(t, IsNonNegative(time.Time{}))
+	assert.False(t, IsPositive(time.Time{}))
+	assert.True(t, IsNegative(time.Time{}.Add(-time.Hour)))
+	assert.False(t, IsNonNegative(time.Time{}.Add(-time.Hour)))
+
+	// *big.Int
+	assert.True(t, IsLessable(big.NewInt(1)))
+	assert.True(t, IsLessThan(big.NewInt(2))(big.NewInt(1)))
+	assert.True(t, IsLessThanEquals(big.NewInt(2))(uint8(2)))
+	assert.False(t, IsGreaterThan(big.NewInt(2))(2))
+	assert.True(t, IsNegative(big.NewInt(-5)))
+	assert.True(t, IsNonNegative(big.NewInt(0)))
+	assert.True(t, IsPositive(new(big.Int).Lsh(big.NewInt(1), 100)))
+
+	// *big.Float
+	assert.True(t, IsLessThan(big.NewFloat(1.5))(1))
+	assert.True(t, IsGreaterThan(big.NewFloat(1.5))(big.NewRat(7, 4)))
+	assert.True(t, IsGreaterThanEquals(big.NewFloat(2))(big.NewInt(2)))
+	assert.True(t, IsNegative(big.NewFloat(-0.5)))
+
+	// NaN is unordered
+	assert.False(t, IsLessThan(big.NewFloat(1.5))(math.NaN()))
+	assert.False(t, IsGreaterThanEquals(big.NewFloat(1.5))(math.NaN()))
+	assert.False(t, LessThan(big.NewFloat(1.5))(float32(math.NaN()), big.NewFloat(1)))
+	assert.False(t, IsLessThanEquals(big.NewRat(1, 2))(math.NaN()))
+
+	// *big.Rat
+	assert.True(t, IsLessThan(big.NewRat(1, 3))(0.25))
+	assert.False(t, IsLessThan(big.NewRat(1, 3))(big.NewRat(2, 6)))
+	assert.True(t, IsLessThanEquals(big.NewRat(1, 3))(big.NewRat(2, 6)))
+	assert.True(t, IsPositive(big.NewRat(1, 3)))
+
+	// Not lessable
+	assert.False(t, IsLessable(nil))
+	assert.False(t, IsLessable((*big.Int)(nil)))
+	assert.False(t, IsLessable(struct{}{}))
+
+	func() {
+		defer func() {
+			assert.Equal(t, lessThanErrorMsg, recover())
+		}()
+
+		LessThan(struct{}{})
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(signErrorMsg, semver{1, 2, 3}), recover())
+		}()
+
+		IsNegative(semver{1, 2, 3})
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, lessThanErrorMsg, recover())
+		}()
+
+		IsPositive(nil)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, "a cannot be converted to *big.Int", recover())
+		}()
+
+		IsLessThan(big.NewInt(1))("a")
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, "1.5 cannot be converted to *big.Int", recover())
+		}()
+
+		IsLessThan(big.NewInt(1))(1.5)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, "+Inf cannot be converted to *big.Rat", recover())
+		}()
+
+		IsLessThan(big.NewRat(1, 2))(math.Inf(1))
+		assert.Fail(t, "must panic")
+	}()
+}
+
+// cents is an int kind that orders in reverse, to verify Compare takes precedence over kind
+type cents int
+
+func (c cents) Compare(other interface{}) int {
+	return int(other.(cents)) - int(c)
+}
+
+type semver struct {
+	major, minor, patch int
+}
+
+func (s semver) Less(other interface{}) bool {
+	o := other.(semver)
+	if s.major != o.major {
+		return s.major < o.major
+	}
+
+	if s.minor != o.minor {
+		return s.minor < o.minor
+	}
+
+	return s.patch < o.patch
+}
+
+func TestLessThanComparable(t *testing.T) {
+	// Comparable
+	assert.True(t, IsLessable(cents(0)))
+	assert.True(t, LessThan(cents(0))(cents(5), cents(3)))
+	assert.True(t, IsLessThan(cents(3))(cents(5)))
+	assert.True(t, IsLessThanEquals(cents(3))(cents(3)))
+	assert.True(t, IsGreaterThan(cents(3))(1))
+	assert.False(t, IsGreaterThanEquals(cents(3))(5))
+
+	// Lesser
+	var (
+		v1 = semver{1, 2, 3}
+		v2 = semver{1, 10, 0}
+	)
+	assert.True(t, IsLessable(v1))
+	assert.True(t, LessThan(v1)(v1, v2))
+	assert.False(t, LessThan(v1)(v2, v1))
+	assert.True(t, IsLessThanEquals(v1)(v1))
+	assert.True(t, IsGreaterThan(v1)(v2))
+	assert.False(t, IsGreaterThanEquals(v2)(v1))
+
+	// Sort helpers
+	vers := []semver{v2, {0, 1, 0}, v1}
+	Sort(vers, NaturalSortFunc)
+	assert.Equal(t, []semver{{0, 1, 0}, v1, v2}, vers)
+
+	amounts := []cents{1, 3, 2}
+	Sort(amounts, NaturalSortFunc)
+	assert.Equal(t, []cents{3, 2, 1}, amounts)
+
+	assert.Equal(t, v2, MaxBy([]semver{v1, v2}, NaturalSortFunc))
+	assert.Equal(t, -1, ComparatorByKey(func(s semver) semver { return s }, nil)(v1, v2))
+
+	// NaturalSortFunc also works for other lessable types
+	assert.True(t, NaturalSortFunc(1, 2))
+	assert.True(t, NaturalSortFunc("a", "b"))
+}
+
+func TestLessThanNumericExact(t *testing.T) {
+	// Default conversion to the type of val wraps around
+	assert.False(t, LessThan(uint(0))(int64(-1), uint(1)))
+
+	// Negative ints are less than all uints
+	lt := LessThan(uint(0), NumericExact)
+	assert.True(t, lt(int64(-1), uint(1)))
+	assert.False(t, lt(uint(1), int64(-1)))
+	assert.True(t, lt(int8(1), uint64(math.MaxUint64)))
+	assert.True(t, lt(uint8(1), 2))
+
+	// Large uints do not overflow when val is an int
+	lt = LessThan(0, NumericExact)
+	assert.True(t, lt(math.MaxInt64, uint64(math.MaxUint64)))
+	assert.False(t, lt(uint64(math.MaxUint64), math.MaxInt64))
+	assert.True(t, IsGreaterThan(0, NumericExact)(uint64(math.MaxUint64)))
+
+	// Floats vs large ints are compared without rounding
+	// float64(2^53 + 1) rounds to 2^53
+	assert.True(t, lt(float64(1<<53), (1<<53)+1))
+	assert.False(t, lt((1<<53)+1, float64(1<<53)))
+	assert.True(t, lt(1.5, 2))
+	assert.True(t, lt(1, 1.5))
+	assert.True(t, lt(-1.5, -1))
+	assert.True(t, lt(-2, -1.5))
+	assert.True(t, lt(math.Inf(-1), math.MinInt64))
+	assert.True(t, lt(uint64(math.MaxUint64), math.Inf(1)))
+	assert.True(t, lt(-0.5, uint(0)))
+	assert.True(t, lt(float64(1<<63), uint64(1<<63)+1))
+	assert.True(t, lt(math.MaxInt64, float64(1<<63)))
+	assert.True(t, LessThanEquals(0, NumericExact)(2.0, uint(2)))
+	assert.True(t, GreaterThanEquals(0, NumericExact)(uint(2), 2.0))
+	assert.True(t, lt(float32(1.5), 1.75))
+
+	// NaN is neither less than, equal to, nor greater than anything
+	nan := math.NaN()
+	for _, val := range []interface{}{nan, 1, uint(1), 1.0} {
+		assert.False(t, lt(nan, val))
+		assert.False(t, lt(val, nan))
+		assert.False(t, LessThanEquals(0, NumericExact)(nan, val))
+		assert.False(t, GreaterThan(0, NumericExact)(nan, val))
+		assert.False(t, GreaterThan(0, NumericExact)(val, nan))
+		assert.False(t, GreaterThanEquals(0, NumericExact)(val, nan))
+	}
+
+	// NumericExact does not apply to strings
+	assert.True(t, IsLessThan("b", NumericExact)("a"))
+
+	// IsNegative and friends are exact
+	assert.False(t, IsNegative(uint64(math.MaxUint64)))
+	assert.True(t, IsPositive(uint64(math.MaxUint64)))
+	assert.True(t, IsNegative(-0.5))
+	assert.True(t, IsPositive(0.5))
+	assert.False(t, IsNegative(nan))
+	assert.False(t, IsNonNegative(nan))
+	assert.False(t, IsPositive(nan))
+
+	func() {
+		defer func() {
+			assert.Equal(t, "a must be of an int, uint, or float kind", recover())
+		}()
+
+		lt(1, "a")
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestLessThanNaN(t *testing.T) {
+	var (
+		nan     = math.NaN()
+		negZero = math.Copysign(0, -1)
+	)
+
+	// By default, NaN cannot be ordered, and -0 == +0
+	for _, val := range []interface{}{nan, 1.0} {
+		assert.False(t, LessThan(0.0)(nan, val))
+		assert.False(t, LessThanEquals(0.0)(nan, val))
+		assert.False(t, GreaterThan(0.0)(nan, val))
+		assert.False(t, GreaterThanEquals(0.0)(nan, val))
+		assert.False(t, GreaterThan(0.0)(val, nan))
+	}
+	assert.False(t, LessThan(0.0)(negZero, 0.0))
+	assert.True(t, LessThanEquals(0.0)(0.0, negZero))
+
+	// NaNFirst
+	lt := LessThan(0.0, NaNFirst)
+	assert.True(t, lt(nan, math.Inf(-1)))
+	assert.False(t, lt(math.Inf(-1), nan))
+	assert.False(t, lt(nan, nan))
+	assert.True(t, LessThanEquals(0.0, NaNFirst)(nan, nan))
+	assert.True(t, GreaterThan(float32(0), NaNFirst)(float32(1), float32(nan)))
+	assert.True(t, lt(1.0, 2.0))
+
+	// NaNLast
+	lt = LessThan(0.0, NaNLast)
+	assert.False(t, lt(nan, math.Inf(1)))
+	assert.True(t, lt(math.Inf(1), nan))
+	assert.True(t, IsGreaterThan(1.0, NaNLast)(nan))
+	assert.True(t, IsGreaterThanEquals(nan, NaNLast)(nan))
+
+	// SignedZero
+	lt = LessThan(0.0, SignedZero)
+	assert.True(t, lt(negZero, 0.0))
+	assert.False(t, lt(0.0, negZero))
+	assert.True(t, GreaterThan(0.0, SignedZero)(0.0, negZero))
+	assert.False(t, lt(nan, 0.0))
+
+	// Combined with NumericExact
+	lt = LessThan(0, NumericExact, NaNFirst, SignedZero)
+	assert.True(t, lt(nan, math.MinInt64))
+	assert.True(t, lt(negZero, 0))
+	assert.True(t, lt(negZero, uint(0)))
+	assert.False(t, lt(0, negZero))
+
+	// Sort funcs
+	floats := []float64{2, nan, 0, negZero, math.Inf(-1), nan}
+	Sort(floats, KindSortFunc(reflect.Float64, NaNLast, SignedZero))
+	assert.Equal(t, "[-Inf -0 0 2 NaN NaN]", fmt.Sprint(floats))
+
+	Sort(floats, KindSortFuncDesc(reflect.Float64, NaNLast))
+	assert.Equal(t, "NaN", fmt.Sprint(floats[0]))
+	assert.Equal(t, "NaN", fmt.Sprint(floats[1]))
+	assert.Equal(t, float64(2), floats[2])
+	assert.Equal(t, math.Inf(-1), floats[5])
+
+	float32s := []float32{1, float32(nan), -1}
+	Sort(float32s, KindSortFunc(reflect.Float32, NaNFirst))
+	assert.Equal(t, "[NaN -1 1]", fmt.Sprint(float32s))
+
+	func() {
+		defer func() {
+			assert.Equal(t, "val1 and val2 must be of kind float32", recover())
+		}()
+
+		KindSortFunc(reflect.Float32, NaNFirst)(1.0, 2.0)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestIsNaNFiniteInf(t *testing.T) {
+	var (
+		nan  = math.NaN()
+		inf  = math.Inf(1)
+		ninf = math.Inf(-1)
+	)
+
+	assert.True(t, IsNaN(nan))
+	assert.True(t, IsNaN(float32(nan)))
+	assert.True(t, IsNaN(complex(1, nan)))
+	assert.False(t, IsNaN(inf))
+	assert.False(t, IsNaN(1))
+	assert.False(t, IsNaN(uint8(1)))
+
+	assert.True(t, IsFinite(1.0))
+	assert.True(t, IsFinite(1))
+	assert.True(t, IsFinite(complex64(1)))
+	assert.False(t, IsFinite(nan))
+	assert.False(t, IsFinite(ninf))
+	assert.False(t, IsFinite(complex(inf, 0)))
+
+	assert.True(t, IsInf(0)(inf))
+	assert.True(t, IsInf(0)(ninf))
+	assert.True(t, IsInf(1)(inf))
+	assert.False(t, IsInf(1)(ninf))
+	assert.True(t, IsInf(-1)(float32(ninf)))
+	assert.True(t, IsInf(-1)(complex(0, ninf)))
+	assert.False(t, IsInf(0)(nan))
+	assert.False(t, IsInf(0)(math.MaxInt64))
+
+	// Usable as filters
+	res, _ := ParallelFilter(context.Background(), []float64{1, nan, inf}, IsFinite, 1)
+	assert.Equal(t, []float64{1}, res)
+
+	func() {
+		defer func() {
+			assert.Equal(t, "a must be of an int, uint, float, or complex kind", recover())
+		}()
+
+		IsNaN("a")
+		assert.Fail(t, "must panic")
+	}()
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+const (
+	intervalErrorMsg = "lo must be <= hi"
+)
+
+// Bounds specifies whether the lower and upper bounds of an Interval are inclusive or exclusive
+type Bounds uint
+
+const (
+	// Inclusive includes both bounds, eg [lo, hi]
+	Inclusive Bounds = iota
+	// LowerExclusive excludes the lower bound, eg (lo, hi]
+	LowerExclusive
+	// UpperExclusive excludes the upper bound, eg [lo, hi)
+	UpperExclusive
+	// Exclusive excludes both bounds, eg (lo, hi)
+	Exclusive
+)
+
+// Interval is a range of values between a lower and upper bound, where each bound is inclusive or exclusive.
+// The bounds may be any value LessThan accepts, including time.Time.
+// Numbers are compared by their exact values as described by NumericExact, so IsBetween(1, 2)(2.5) is false,
+// and other values are converted to the type of lo first, the same as LessThan(lo).
+// The zero Interval is empty.
+type Interval struct {
+	lo, hi interface{}
+	bounds Bounds
+	cmp    func(val1, val2 interface{}) int
+}
+
+// NewInterval (lo, hi, optional bounds) returns an Interval from lo to hi, which is inclusive if no bounds are given.
+// An interval where lo == hi and either bound is exclusive is empty.
+// Panics if IsLessable(lo) is false, or lo > hi.
+func NewInterval(lo, hi interface{}, bounds ...Bounds) Interval {
+	var (
+		cmp = compareFunc(lo, NumericExact)
+		bnd = Inclusive
+	)
+
+	if len(bounds) > 0 {
+		bnd = bounds[0]
+	}
+
+	res := cmp(lo, hi)
+	PanicBM((res == -1) || (res == 0), intervalErrorMsg)
+
+	return Interval{lo: lo, hi: hi, bounds: bnd, cmp: cmp}
+}
+
+// Lo returns the lower bound
+func (i Interval) Lo() interface{} {
+	return i.lo
+}
+
+// Hi returns the upper bound
+func (i Interval) Hi() interface{} {
+	return i.hi
+}
+
+// Bounds returns whether the lower and upper bounds are inclusive or exclusive
+func (i Interval) Bounds() Bounds {
+	return i.bounds
+}
+
+// lowerExclusive returns true if the lower bound is exclusive
+func (i Interval) lowerExclusive() bool {
+	return i.bounds&LowerExclusive != 0
+}
+
+// upperExclusive returns true if the upper bound is exclusive
+func (i Interval) upperExclusive() bool {
+	return i.bounds&UpperExclusive != 0
+}
+
+// IsEmpty returns true if the interval contains no values, which is only the case if lo == hi and either bound is exclusive,
+// or the interval is the zero Interval
+func (i Interval) IsEmpty() bool {
+	return (i.cmp == nil) || ((i.bounds != Inclusive) && (i.cmp(i.lo, i.hi) == 0))
+}
+
+// Contains returns true if val is within the interval
+func (i Interval) Contains(val interface{}) bool {
+	if i.cmp == nil {
+		return false
+	}
+
+	var (
+		res      = i.cmp(val, i.lo)
+		aboveLow = (res == 1) || ((res == 0) && !i.lowerExclusive())
+	)
+
+	if !aboveLow {
+		return false
+	}
+
+	res = i.cmp(val, i.hi)
+	return (res == -1) || ((res == 0) && !i.upperExclusive())
+}
+
+// Intersect returns the interval of values both intervals contain, and true if that interval is not empty.
+// If the intervals do not overlap, or either is the zero Interval, the result is a zero Interval and false.
+func (i Interval) Intersect(other Interval) (Interval, bool) {
+	if (i.cmp == nil) || (other.cmp == nil) {
+		return Interval{}, false
+	}
+
+	// Lower bound is the greater of the two lower bounds, exclusive if either equal bound is exclusive
+	var (
+		lo     = i.lo
+		bounds = i.bounds & LowerExclusive
+	)
+
+	switch i.cmp(i.lo, other.lo) {
+	case -1:
+		lo, bounds = other.lo, other.bounds&LowerExclusive
+	case 0:
+		bounds |= other.bounds & LowerExclusive
+	}
+
+	// Upper bound is the lesser of the two upper bounds, exclusive if either equal bound is exclusive
+	hi := i.hi
+	switch i.cmp(i.hi, other.hi) {
+	case -1:
+		bounds |= i.bounds & UpperExclusive
+	case 0:
+		bounds |= (i.bounds | other.bounds) & UpperExclusive
+	default:
+		hi, bounds = other.hi, bounds|(other.bounds&UpperExclusive)
+	}
+
+	// The intersection is empty if lo > hi, or lo == hi and either bound is exclusive
+	switch i.cmp(lo, hi) {
+	case -1:
+	case 0:
+		if bounds != Inclusive {
+			return Interval{}, false
+		}
+	default:
+		return Interval{}, false
+	}
+
+	return Interval{lo: lo, hi: hi, bounds: bounds, cmp: i.cmp}, true
+}
+
+// Overlaps returns true if there is at least one value both intervals contain
+func (i Interval) Overlaps(other Interval) bool {
+	_, overlaps := i.Intersect(other)
+	return overlaps
+}
+
+// IsBetween (lo, hi, optional bounds) returns a func(interface{}) bool that returns true if the arg is within NewInterval(lo, hi, bounds...).
+// Panics if IsLessable(lo) is false, or lo > hi.
+func IsBetween(lo, hi interface{}, bounds ...Bounds) func(interface{}) bool {
+	return NewInterval(lo, hi, bounds...).Contains
+}
+
+// IsOneOf (vals) returns a func(interface{}) bool that returns true if the arg is equal to any of the vals, according to EqualTo.
+// If no vals are given, the func always returns false.
+func IsOneOf(vals ...interface{}) func(interface{}) bool {
+	fns := make([]interface{}, len(vals))
+	for i, val := range vals {
+		fns[i] = EqualTo(val)
+	}
+
+	return Or(fns...)
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBetween(t *testing.T) {
+	// Inclusive by default
+	fn := IsBetween(1, 3)
+	assert.False(t, fn(0))
+	assert.True(t, fn(1))
+	assert.True(t, fn(int8(2)))
+	assert.True(t, fn(3))
+	assert.False(t, fn(4))
+
+	fn = IsBetween(1, 3, LowerExclusive)
+	assert.False(t, fn(1))
+	assert.True(t, fn(3))
+
+	fn = IsBetween(1, 3, UpperExclusive)
+	assert.True(t, fn(1))
+	assert.False(t, fn(3))
+
+	fn = IsBetween(1.0, 3.0, Exclusive)
+	assert.False(t, fn(1))
+	assert.True(t, fn(1.5))
+	assert.False(t, fn(3))
+	assert.False(t, fn(math.NaN()))
+
+	// Numbers are compared exactly, not converted to the type of the bounds
+	assert.False(t, IsBetween(1, 2)(2.5))
+	assert.True(t, IsBetween(1, 2, LowerExclusive)(1.5))
+	assert.False(t, IsBetween(0, 10)(-1.0))
+	assert.False(t, IsBetween(0, 10)(uint64(math.MaxUint64)))
+
+	fn = IsBetween("b", "d")
+	assert.True(t, fn("c"))
+	assert.False(t, fn("e"))
+
+	// time.Time
+	var (
+		now  = time.Now()
+		then = now.Add(time.Hour)
+	)
+	fn = IsBetween(now, then, UpperExclusive)
+	assert.True(t, fn(now))
+	assert.True(t, fn(now.Add(time.Minute)))
+	assert.False(t, fn(then))
+
+	func() {
+		defer func() {
+			assert.Equal(t, intervalErrorMsg, recover())
+		}()
+
+		IsBetween(3, 1)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, lessThanErrorMsg, recover())
+		}()
+
+		IsBetween(struct{}{}, struct{}{})
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestIsOneOf(t *testing.T) {
+	fn := IsOneOf(1, 3, 5)
+	assert.True(t, fn(1))
+	assert.True(t, fn(int8(5)))
+	assert.False(t, fn(2))
+
+	fn = IsOneOf("x", "y")
+	assert.True(t, fn("y"))
+	assert.False(t, fn("z"))
+
+	assert.False(t, IsOneOf()(1))
+}
+
+func TestInterval(t *testing.T) {
+	i := NewInterval(1, 5, LowerExclusive)
+	assert.Equal(t, 1, i.Lo())
+	assert.Equal(t, 5, i.Hi())
+	assert.Equal(t, LowerExclusive, i.Bounds())
+	assert.False(t, i.IsEmpty())
+	assert.False(t, i.Contains(1))
+	assert.True(t, i.Contains(5))
+
+	// Empty
+	assert.False(t, NewInterval(1, 1).IsEmpty())
+	assert.True(t, NewInterval(1, 1).Contains(1))
+	assert.True(t, NewInterval(1, 1, UpperExclusive).IsEmpty())
+	assert.False(t, NewInterval(1, 1, UpperExclusive).Contains(1))
+
+	// Overlapping
+	res, ok := NewInterval(1, 5).Intersect(NewInterval(3, 7, UpperExclusive))
+	assert.True(t, ok)
+	assert.Equal(t, 3, res.Lo())
+	assert.Equal(t, 5, res.Hi())
+	assert.Equal(t, Inclusive, res.Bounds())
+
+	res, ok = NewInterval(3, 7, UpperExclusive).Intersect(NewInterval(1, 5))
+	assert.True(t, ok)
+	assert.Equal(t, 3, res.Lo())
+	assert.Equal(t, 5, res.Hi())
+	assert.Equal(t, Inclusive, res.Bounds())
+
+	// Same bounds, exclusivity is combined
+	res, ok = NewInterval(1, 5, LowerExclusive).Intersect(NewInterval(1, 5, UpperExclusive))
+	assert.True(t, ok)
+	assert.Equal(t, Exclusive, res.Bounds())
+
+	// Contained
+	res, ok = NewInterval(1, 10).Intersect(NewInterval(2, 3, Exclusive))
+	assert.True(t, ok)
+	assert.Equal(t, 2, res.Lo())
+	assert.Equal(t, 3, res.Hi())
+	assert.Equal(t, Exclusive, res.Bounds())
+
+	// Touching
+	res, ok = NewInterval(1, 3).Intersect(NewInterval(3, 5))
+	assert.True(t, ok)
+	assert.Equal(t, 3, res.Lo())
+	assert.Equal(t, 3, res.Hi())
+	assert.True(t, NewInterval(1, 3).Overlaps(NewInterval(3, 5)))
+	assert.False(t, NewInterval(1, 3, UpperExclusive).Overlaps(NewInterval(3, 5)))
+	assert.False(t, NewInterval(1, 3).Overlaps(NewInterval(3, 5, LowerExclusive)))
+
+	// Disjoint
+	res, ok = NewInterval(1, 2).Intersect(NewInterval(3, 4))
+	assert.False(t, ok)
+	assert.Equal(t, Interval{}, res)
+	assert.False(t, NewInterval(3, 4).Overlaps(NewInterval(1, 2)))
+
+	// The zero Interval is empty, and overlaps nothing
+	var zero Interval
+	assert.True(t, zero.IsEmpty())
+	assert.False(t, zero.Contains(1))
+	assert.False(t, zero.Overlaps(NewInterval(1, 2)))
+	assert.False(t, NewInterval(1, 2).Overlaps(zero))
+
+	// time.Time
+	var (
+		now   = time.Now()
+		day   = NewInterval(now, now.Add(24*time.Hour), UpperExclusive)
+		night = NewInterval(now.Add(20*time.Hour), now.Add(30*time.Hour))
+	)
+	assert.True(t, day.Overlaps(night))
+	res, _ = day.Intersect(night)
+	assert.Equal(t, now.Add(20*time.Hour), res.Lo())
+	assert.Equal(t, now.Add(24*time.Hour), res.Hi())
+	assert.Equal(t, UpperExclusive, res.Bounds())
+}
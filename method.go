@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+const (
+	methodNameErrorMsg   = "%q is not a valid exported method name"
+	noMethodErrorMsg     = "%s has no method named %s"
+	methodMapErrorMsg    = "method %s of %s must accept no arguments and return one value"
+	methodFilterErrorMsg = "method %s of %s must accept no arguments and return bool"
+)
+
+// methodKey is a type, method name, and whether the method must return bool, the key of methodInfos
+type methodKey struct {
+	typ    reflect.Type
+	name   string
+	filter bool
+}
+
+// methodInfo is the index of a method in the method set of a type, and whether it is only in the method set of a pointer to the type
+type methodInfo struct {
+	index int
+	ptr   bool
+}
+
+// methodInfos caches the methodInfo of each methodKey looked up
+var methodInfos sync.Map
+
+// lookupMethod returns the methodInfo of the named method of typ or a pointer to typ.
+// Panics if there is no such method, it accepts args, does not return one value, or filter is true and it does not return bool.
+func lookupMethod(typ reflect.Type, name string, filter bool) methodInfo {
+	key := methodKey{typ, name, filter}
+	if info, haveIt := methodInfos.Load(key); haveIt {
+		return info.(methodInfo)
+	}
+
+	var (
+		method, haveIt = typ.MethodByName(name)
+		info           = methodInfo{index: method.Index}
+	)
+
+	// A value can call a pointer receiver method by copying it into a new pointer
+	if !haveIt && (typ.Kind() != reflect.Ptr) {
+		method, haveIt = reflect.PtrTo(typ).MethodByName(name)
+		info = methodInfo{index: method.Index, ptr: true}
+	}
+
+	if !haveIt {
+		panic(fmt.Sprintf(noMethodErrorMsg, typ, name))
+	}
+
+	// The method type includes the receiver as the first arg
+	mtyp := method.Type
+	if filter {
+		PanicBM((mtyp.NumIn() == 1) && (mtyp.NumOut() == 1) && (mtyp.Out(0).Kind() == reflect.Bool), fmt.Sprintf(methodFilterErrorMsg, name, typ))
+	} else {
+		PanicBM((mtyp.NumIn() == 1) && (mtyp.NumOut() == 1), fmt.Sprintf(methodMapErrorMsg, name, typ))
+	}
+
+	methodInfos.Store(key, info)
+	return info
+}
+
+// callMethod calls the named method of arg, and returns the result.
+// Returns false if arg is nil.
+func callMethod(arg interface{}, name string, filter bool) (reflect.Value, bool) {
+	if IsNil(arg) {
+		return reflect.Value{}, false
+	}
+
+	var (
+		rv   = reflect.ValueOf(arg)
+		info = lookupMethod(rv.Type(), name, filter)
+	)
+
+	if info.ptr {
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		rv = ptr
+	}
+
+	return rv.Method(info.index).Call(nil)[0], true
+}
+
+// MethodMap (name) returns a func(interface{}) interface{} that calls the named method of the arg and returns the result.
+// The method must accept no args and return one value.
+// The arg may be a value or a pointer, and the method may have a value or pointer receiver.
+// If the arg is a value and the method has a pointer receiver, the method is called on a pointer to a copy of the arg.
+// The func returns nil if the arg is nil, and the method lookup is cached per type.
+// Panics if name is not a valid exported method name.
+// The func panics if the arg has no such method, or the method does not accept no args and return one value.
+func MethodMap(name string) func(interface{}) interface{} {
+	PanicBM(isExportedName(name), fmt.Sprintf(methodNameErrorMsg, name))
+
+	return func(arg interface{}) interface{} {
+		res, ok := callMethod(arg, name, false)
+		if !ok {
+			return nil
+		}
+
+		return res.Interface()
+	}
+}
+
+// MethodFilter (name) returns a func(interface{}) bool that calls the named method of the arg and returns the result.
+// The method must accept no args and return bool.
+// The arg and method receiver are handled as described by MethodMap.
+// The func returns false if the arg is nil, and the method lookup is cached per type.
+// Panics if name is not a valid exported method name.
+// The func panics if the arg has no such method, or the method does not accept no args and return bool.
+func MethodFilter(name string) func(interface{}) bool {
+	PanicBM(isExportedName(name), fmt.Sprintf(methodNameErrorMsg, name))
+
+	return func(arg interface{}) bool {
+		res, ok := callMethod(arg, name, true)
+		return ok && res.Bool()
+	}
+}
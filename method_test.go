@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type methodAccount struct {
+	Status  string
+	Balance int
+}
+
+func (a methodAccount) IsActive() bool {
+	return a.Status == "active"
+}
+
+func (a methodAccount) Label() string {
+	return a.Status + ":" + fmt.Sprint(a.Balance)
+}
+
+func (a *methodAccount) IsOverdrawn() bool {
+	return a.Balance < 0
+}
+
+func (a *methodAccount) Close() {
+	a.Status = "closed"
+}
+
+func (a methodAccount) Deposit(amount int) int {
+	return a.Balance + amount
+}
+
+type methodFlag bool
+
+type methodFlagged struct{}
+
+func (methodFlagged) Flag() methodFlag {
+	return true
+}
+
+func TestMethodMap(t *testing.T) {
+	var (
+		acct  = methodAccount{Status: "active", Balance: -5}
+		label = MethodMap("Label")
+	)
+
+	// Value and pointer args with a value receiver
+	assert.Equal(t, "active:-5", label(acct))
+	assert.Equal(t, "active:-5", label(&acct))
+
+	// Value and pointer args with a pointer receiver
+	overdrawn := MethodMap("IsOverdrawn")
+	assert.Equal(t, true, overdrawn(acct))
+	assert.Equal(t, true, overdrawn(&acct))
+
+	// Nil
+	assert.Nil(t, label(nil))
+	assert.Nil(t, label((*methodAccount)(nil)))
+
+	// Usable with Map
+	assert.Equal(t, "active:-5", Map(label)(acct))
+
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(methodNameErrorMsg, "label"), recover())
+		}()
+
+		MethodMap("label")
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(noMethodErrorMsg, "gofuncs.methodAccount", "Missing"), recover())
+		}()
+
+		MethodMap("Missing")(acct)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(methodMapErrorMsg, "Deposit", "gofuncs.methodAccount"), recover())
+		}()
+
+		MethodMap("Deposit")(acct)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(methodMapErrorMsg, "Close", "gofuncs.methodAccount"), recover())
+		}()
+
+		MethodMap("Close")(acct)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestMethodFilter(t *testing.T) {
+	var (
+		active   = MethodFilter("IsActive")
+		accounts = []methodAccount{{Status: "active"}, {Status: "closed", Balance: -1}}
+	)
+
+	assert.True(t, active(accounts[0]))
+	assert.False(t, active(&accounts[1]))
+	assert.False(t, active(nil))
+
+	overdrawn := MethodFilter("IsOverdrawn")
+	assert.False(t, overdrawn(accounts[0]))
+	assert.True(t, overdrawn(accounts[1]))
+	assert.True(t, overdrawn(&accounts[1]))
+
+	// Named bool results
+	assert.True(t, MethodFilter("Flag")(methodFlagged{}))
+
+	// Composable
+	assert.True(t, And(Not(active), overdrawn)(accounts[1]))
+
+	func() {
+		defer func() {
+			assert.Equal(t, fmt.Sprintf(methodFilterErrorMsg, "Label", "gofuncs.methodAccount"), recover())
+		}()
+
+		MethodFilter("Label")(accounts[0])
+		assert.Fail(t, "must panic")
+	}()
+
+	// The same method may be valid as a map but not a filter
+	assert.Equal(t, "active:0", MethodMap("Label")(accounts[0]))
+}
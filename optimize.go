@@ -0,0 +1,351 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"reflect"
+	"sort"
+)
+
+// cost returns Cost if it is set, else an estimate:
+// 0 for OpTrue and OpFalse, 1 for a comparison, 1 plus the cost of the arg for OpField, and the sum of the costs of the args otherwise.
+func (p *Predicate) cost() float64 {
+	if p.Cost > 0 {
+		return p.Cost
+	}
+
+	switch p.Op {
+	case OpTrue, OpFalse:
+		return 0
+	case OpEqualTo, OpLessThan, OpLessThanEquals, OpGreaterThan, OpGreaterThanEquals:
+		return 1
+	}
+
+	var total float64
+	if p.Op == OpField {
+		total = 1
+	}
+
+	for _, arg := range p.Args {
+		total += arg.cost()
+	}
+
+	return total
+}
+
+// compareValues compares two Values the same way as the ordering ops, returning -1, 0, or 1.
+// Returns false if the values cannot be compared to each other: they must both be numbers, or both be lessable values of the same type.
+func compareValues(val1, val2 interface{}) (int, bool) {
+	if isNumeric(val1) && isNumeric(val2) {
+		res := compareNumbers(val1, val2)
+		return res, res != unordered
+	}
+
+	if !IsLessable(val1) || (reflect.TypeOf(val1) != reflect.TypeOf(val2)) {
+		return 0, false
+	}
+
+	res := compareFunc(val1)(val1, val2)
+	return res, res != unordered
+}
+
+// isContradiction returns true if no value can satisfy all of the comparisons in args, which are the args of an And.
+// Only the EqualTo and ordering args are considered, and only if all of their values can be compared to each other.
+// Also returns the args without redundant ordering args, which are those implied by a tighter bound of the same direction.
+func isContradiction(args []*Predicate) (bool, []*Predicate) {
+	var (
+		lo, hi *Predicate
+		eqs    []*Predicate
+		first  interface{}
+		n      int
+	)
+
+	for _, arg := range args {
+		switch arg.Op {
+		case OpEqualTo:
+			if arg.Value == nil {
+				continue
+			}
+			eqs = append(eqs, arg)
+		case OpLessThan, OpLessThanEquals, OpGreaterThan, OpGreaterThanEquals:
+		default:
+			continue
+		}
+
+		// Give up unless all values can be compared to the first one
+		if n++; n == 1 {
+			first = arg.Value
+		} else if _, ok := compareValues(first, arg.Value); !ok {
+			return false, args
+		}
+
+		switch arg.Op {
+		case OpLessThan, OpLessThanEquals:
+			// A lower value is tighter, and < is tighter than <= the same value
+			if hi == nil {
+				hi = arg
+			} else if res, _ := compareValues(arg.Value, hi.Value); (res < 0) || ((res == 0) && (arg.Op == OpLessThan)) {
+				hi = arg
+			}
+
+		case OpGreaterThan, OpGreaterThanEquals:
+			// A higher value is tighter, and > is tighter than >= the same value
+			if lo == nil {
+				lo = arg
+			} else if res, _ := compareValues(arg.Value, lo.Value); (res > 0) || ((res == 0) && (arg.Op == OpGreaterThan)) {
+				lo = arg
+			}
+		}
+	}
+
+	// The lower bound must be below the upper bound, or equal if both are inclusive
+	if (lo != nil) && (hi != nil) {
+		if res, _ := compareValues(lo.Value, hi.Value); (res > 0) || ((res == 0) && ((lo.Op == OpGreaterThan) || (hi.Op == OpLessThan))) {
+			return true, args
+		}
+	}
+
+	for i, eq := range eqs {
+		// All equal values must be the same value
+		if i > 0 {
+			if res, _ := compareValues(eqs[0].Value, eq.Value); res != 0 {
+				return true, args
+			}
+		}
+
+		// An equal value must be within the bounds
+		if lo != nil {
+			if res, _ := compareValues(eq.Value, lo.Value); (res < 0) || ((res == 0) && (lo.Op == OpGreaterThan)) {
+				return true, args
+			}
+		}
+
+		if hi != nil {
+			if res, _ := compareValues(eq.Value, hi.Value); (res > 0) || ((res == 0) && (hi.Op == OpLessThan)) {
+				return true, args
+			}
+		}
+	}
+
+	// Keep only the tightest bounds
+	var res []*Predicate
+	for _, arg := range args {
+		switch arg.Op {
+		case OpLessThan, OpLessThanEquals:
+			if arg != hi {
+				continue
+			}
+		case OpGreaterThan, OpGreaterThanEquals:
+			if arg != lo {
+				continue
+			}
+		}
+
+		res = append(res, arg)
+	}
+
+	return false, res
+}
+
+// mergeFields combines the OpField args of an And or Or that have the same path into a single OpField of an And or Or of their args,
+// so that comparisons of the same field can be checked for contradictions.
+// The merged field is placed where the first of them was, and has the sum of their costs if any of them has a Cost.
+func mergeFields(op PredicateOp, args []*Predicate) []*Predicate {
+	var (
+		res    []*Predicate
+		byPath = map[string]int{}
+		merged = map[string][]*Predicate{}
+	)
+
+	for _, arg := range args {
+		if arg.Op != OpField {
+			res = append(res, arg)
+			continue
+		}
+
+		if _, haveIt := byPath[arg.Path]; !haveIt {
+			byPath[arg.Path] = len(res)
+			res = append(res, arg)
+		}
+		merged[arg.Path] = append(merged[arg.Path], arg)
+	}
+
+	for path, fields := range merged {
+		if len(fields) == 1 {
+			continue
+		}
+
+		var (
+			fieldArgs = make([]*Predicate, len(fields))
+			cost      float64
+			hasCost   bool
+		)
+
+		for i, field := range fields {
+			fieldArgs[i] = field.Args[0]
+			cost += field.cost()
+			hasCost = hasCost || (field.Cost > 0)
+		}
+
+		field := PredField(path, &Predicate{Op: op, Args: fieldArgs}).optimize()
+		if hasCost {
+			field.Cost = cost
+		}
+
+		res[byPath[path]] = field
+	}
+
+	return res
+}
+
+// samePredicate returns true if p1 and p2 have the same op, path, and value of the same type, and their args are the same.
+// Costs are not compared.
+func samePredicate(p1, p2 *Predicate) bool {
+	if (p1.Op != p2.Op) ||
+		(p1.Path != p2.Path) ||
+		(len(p1.Args) != len(p2.Args)) ||
+		!reflect.DeepEqual(p1.Value, p2.Value) {
+		return false
+	}
+
+	for i, arg := range p1.Args {
+		if !samePredicate(arg, p2.Args[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// optimizeJunction optimizes an And or Or
+func (p *Predicate) optimizeJunction() *Predicate {
+	// For And, True is the identity and False absorbs everything, and the opposite for Or
+	identity, absorbing := OpTrue, OpFalse
+	if p.Op == OpOr {
+		identity, absorbing = OpFalse, OpTrue
+	}
+
+	var (
+		args []*Predicate
+		seen = map[string][]*Predicate{}
+	)
+
+	// add adds an optimized arg, returning false if it absorbs everything
+	add := func(arg *Predicate) bool {
+		switch arg.Op {
+		case identity:
+			return true
+		case absorbing:
+			return false
+		}
+
+		// Predicates with values of different types can print the same, so the string only narrows the candidates
+		key := arg.String()
+		for _, other := range seen[key] {
+			if samePredicate(arg, other) {
+				return true
+			}
+		}
+
+		seen[key] = append(seen[key], arg)
+		args = append(args, arg)
+
+		return true
+	}
+
+	for _, arg := range p.Args {
+		// Flatten nested args of the same op, which are already flattened as they have been optimized
+		optArg := arg.optimize()
+		nested := []*Predicate{optArg}
+		if optArg.Op == p.Op {
+			nested = optArg.Args
+		}
+
+		for _, nestedArg := range nested {
+			if !add(nestedArg) {
+				return &Predicate{Op: absorbing}
+			}
+		}
+	}
+
+	args = mergeFields(p.Op, args)
+	for _, arg := range args {
+		if arg.Op == absorbing {
+			return &Predicate{Op: absorbing}
+		}
+	}
+
+	if p.Op == OpAnd {
+		var contradiction bool
+		if contradiction, args = isContradiction(args); contradiction {
+			return PredFalse()
+		}
+	}
+
+	switch len(args) {
+	case 0:
+		return &Predicate{Op: identity}
+	case 1:
+		return args[0]
+	}
+
+	// Evaluate cheaper args first, keeping the original order of args of the same cost
+	sort.SliceStable(args, func(i, j int) bool {
+		return args[i].cost() < args[j].cost()
+	})
+
+	return &Predicate{Op: p.Op, Args: args, Cost: p.Cost}
+}
+
+// optimize returns an optimized copy of the tree, which must be valid
+func (p *Predicate) optimize() *Predicate {
+	switch p.Op {
+	case OpAnd, OpOr:
+		return p.optimizeJunction()
+
+	case OpNot:
+		switch arg := p.Args[0].optimize(); arg.Op {
+		case OpTrue:
+			return PredFalse()
+		case OpFalse:
+			return PredTrue()
+		case OpNot:
+			return arg.Args[0]
+		default:
+			return &Predicate{Op: OpNot, Args: []*Predicate{arg}, Cost: p.Cost}
+		}
+
+	case OpField:
+		// A field that is never true is false, but a field that is always true is still false if the field does not exist
+		arg := p.Args[0].optimize()
+		if arg.Op == OpFalse {
+			return arg
+		}
+
+		return &Predicate{Op: OpField, Path: p.Path, Args: []*Predicate{arg}, Cost: p.Cost}
+	}
+
+	res := *p
+	return &res
+}
+
+// Optimize returns an equivalent tree that is faster to evaluate, leaving this tree unchanged.
+// Predicates are assumed to have no side effects, so they can be removed and reordered. Optimize:
+//   - flattens And and Or args that are of the same op
+//   - removes duplicate args of And and Or, which have the same op, path, and args, and deep equal values of the same type, ignoring costs,
+//     so EqualTo(1) and EqualTo(int8(1)) are not duplicates
+//   - removes OpTrue args of And and OpFalse args of Or, and replaces an And containing OpFalse with OpFalse, and an Or containing OpTrue with OpTrue
+//   - replaces an And or Or with no args with OpTrue or OpFalse, and one with a single arg with the arg
+//   - replaces Not(True) with False, Not(False) with True, and Not(Not(x)) with x
+//   - combines OpField args of an And or Or with the same path into one OpField of an And or Or
+//   - replaces an And of comparisons that cannot all be true with OpFalse, eg And(LessThan(3), GreaterThan(5)),
+//     as long as all the comparison values are numbers, or lessable values of the same type
+//   - removes ordering args of an And that are implied by a tighter bound, eg And(LessThan(3), LessThan(5)) becomes LessThan(3)
+//   - sorts the args of And and Or by cost, so cheaper args are evaluated first
+//
+// Panics if the tree is invalid.
+func (p *Predicate) Optimize() *Predicate {
+	PanicE(p.Validate())
+
+	return p.optimize()
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredicateOptimize(t *testing.T) {
+	var (
+		a = PredField("a", PredEqualTo(1))
+		b = PredField("b", PredEqualTo(2))
+		c = PredField("c", PredEqualTo(3))
+	)
+
+	for pred, expected := range map[*Predicate]string{
+		// Flattening
+		PredAnd(a, PredAnd(b, PredAnd(c))): `And(Field("a", EqualTo(1)), Field("b", EqualTo(2)), Field("c", EqualTo(3)))`,
+		PredOr(PredOr(a, b), c):            `Or(Field("a", EqualTo(1)), Field("b", EqualTo(2)), Field("c", EqualTo(3)))`,
+		PredAnd(a, PredOr(b, c)):           `And(Field("a", EqualTo(1)), Or(Field("b", EqualTo(2)), Field("c", EqualTo(3))))`,
+
+		// Duplicates
+		PredAnd(a, b, PredField("a", PredEqualTo(1))): `And(Field("a", EqualTo(1)), Field("b", EqualTo(2)))`,
+		PredOr(a, PredOr(a)):                          `Field("a", EqualTo(1))`,
+		PredOr(PredEqualTo(1), PredEqualTo(int8(1))):  `Or(EqualTo(1), EqualTo(1))`,
+
+		// Constants
+		PredAnd(PredTrue(), a):                    `Field("a", EqualTo(1))`,
+		PredAnd(a, PredFalse()):                   `False()`,
+		PredOr(PredFalse(), a):                    `Field("a", EqualTo(1))`,
+		PredOr(a, PredTrue()):                     `True()`,
+		PredAnd():                                 `True()`,
+		PredOr():                                  `False()`,
+		PredAnd(PredOr(), a):                      `False()`,
+		PredNot(PredTrue()):                       `False()`,
+		PredNot(PredAnd()):                        `False()`,
+		PredNot(PredNot(a)):                       `Field("a", EqualTo(1))`,
+		PredField("a", PredOr()):                  `False()`,
+		PredField("a", PredAnd()):                 `Field("a", True())`,
+		PredOr(PredNot(PredNot(PredOr()))):        `False()`,
+		PredAnd(PredTrue(), PredNot(PredFalse())): `True()`,
+
+		// Fields of the same path are merged
+		PredAnd(PredField("a", PredGreaterThan(1)), b, PredField("a", PredLessThan(5))): `And(Field("b", EqualTo(2)), Field("a", And(GreaterThan(1), LessThan(5))))`,
+		PredOr(PredField("a", PredEqualTo(1)), PredField("a", PredEqualTo(2))):          `Field("a", Or(EqualTo(1), EqualTo(2)))`,
+
+		// Contradictions
+		PredAnd(PredLessThan(3), PredGreaterThan(5)):                                 `False()`,
+		PredAnd(PredField("x", PredLessThan(3)), PredField("x", PredGreaterThan(5))): `False()`,
+		PredAnd(PredLessThan(3), PredGreaterThanEquals(3)):                           `False()`,
+		PredAnd(PredLessThanEquals(3), PredGreaterThan(3)):                           `False()`,
+		PredAnd(PredLessThanEquals(3), PredGreaterThanEquals(3.0)):                   `And(LessThanEquals(3), GreaterThanEquals(3))`,
+		PredAnd(PredEqualTo(1), PredEqualTo(2)):                                      `False()`,
+		PredAnd(PredEqualTo(1), PredEqualTo(1.0)):                                    `And(EqualTo(1), EqualTo(1))`,
+		PredAnd(PredEqualTo(5), PredLessThan(5)):                                     `False()`,
+		PredAnd(PredEqualTo(5), PredGreaterThan(uint(5))):                            `False()`,
+		PredAnd(PredEqualTo(5), PredGreaterThanEquals(5)):                            `And(EqualTo(5), GreaterThanEquals(5))`,
+		PredAnd(PredLessThan("b"), PredGreaterThan("c")):                             `False()`,
+		PredOr(a, PredAnd(PredLessThan(3), PredGreaterThan(5))):                      `Field("a", EqualTo(1))`,
+		PredNot(PredAnd(PredLessThan(3), PredGreaterThan(5))):                        `True()`,
+
+		// Values that cannot be compared are left alone
+		PredAnd(PredLessThan("3"), PredGreaterThan(5)):        `And(LessThan("3"), GreaterThan(5))`,
+		PredAnd(PredEqualTo(true), PredEqualTo(false)):        `And(EqualTo(true), EqualTo(false))`,
+		PredAnd(PredLessThan(math.NaN()), PredGreaterThan(5)): `And(LessThan(NaN), GreaterThan(5))`,
+
+		// Redundant bounds
+		PredAnd(PredLessThan(5), PredLessThan(3), PredLessThanEquals(3)):       `LessThan(3)`,
+		PredAnd(PredGreaterThanEquals(1), PredGreaterThan(1), PredLessThan(9)): `And(GreaterThan(1), LessThan(9))`,
+		PredOr(PredLessThan(5), PredLessThan(3)):                               `Or(LessThan(5), LessThan(3))`,
+	} {
+		assert.Equal(t, expected, pred.Optimize().String(), pred.String())
+	}
+}
+
+func TestPredicateOptimizeCost(t *testing.T) {
+	var (
+		cheap  = PredField("a", PredEqualTo(1))
+		nested = PredField("b", PredField("c", PredEqualTo(2)))
+		costly = &Predicate{Op: OpField, Path: "d", Args: []*Predicate{PredEqualTo(3)}, Cost: 10}
+	)
+
+	// Default costs
+	assert.Equal(t, 2.0, cheap.cost())
+	assert.Equal(t, 3.0, nested.cost())
+	assert.Equal(t, 10.0, costly.cost())
+	assert.Equal(t, 15.0, PredAnd(cheap, nested, costly).cost())
+	assert.Equal(t, 0.0, PredTrue().cost())
+
+	// Cheaper args first, and equal costs keep their order
+	assert.Equal(
+		t,
+		`And(Field("a", EqualTo(1)), Field("b", Field("c", EqualTo(2))), Field("d", EqualTo(3)))`,
+		PredAnd(costly, nested, cheap).Optimize().String(),
+	)
+	assert.Equal(
+		t,
+		`Or(LessThan(1), GreaterThan(2), Field("a", EqualTo(1)))`,
+		PredOr(cheap, PredLessThan(1), PredGreaterThan(2)).Optimize().String(),
+	)
+
+	// Merged fields sum their costs if any has a Cost
+	merged := PredAnd(costly, cheap, &Predicate{Op: OpField, Path: "d", Args: []*Predicate{PredLessThan(5)}}).Optimize()
+	assert.Equal(t, `And(Field("a", EqualTo(1)), Field("d", And(EqualTo(3), LessThan(5))))`, merged.String())
+	assert.Equal(t, 12.0, merged.Args[1].Cost)
+
+	// The original is unchanged
+	pred := PredAnd(costly, PredAnd(cheap))
+	pred.Optimize()
+	assert.Equal(t, `And(Field("d", EqualTo(3)), And(Field("a", EqualTo(1))))`, pred.String())
+
+	func() {
+		defer func() {
+			assert.Equal(t, "predicate op \"eq\" has a negative cost -1", recover())
+		}()
+
+		PredAnd(&Predicate{Op: OpEqualTo, Cost: -1}).Optimize()
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestPredicateOptimizeEquivalent(t *testing.T) {
+	pred := PredOr(
+		PredAnd(
+			PredField("age", PredGreaterThan(10)),
+			PredField("age", PredGreaterThanEquals(18)),
+			PredTrue(),
+			PredField("name", PredNot(PredNot(PredEqualTo("Al")))),
+		),
+		PredAnd(PredField("age", PredLessThan(3)), PredField("age", PredGreaterThan(5))),
+		PredOr(PredField("status", PredEqualTo("y")), PredField("status", PredEqualTo("y"))),
+	)
+
+	var (
+		fn1 = pred.Filter()
+		fn2 = pred.Optimize().Filter()
+	)
+
+	for _, val := range []interface{}{
+		exprUser{Name: "Al", Age: 20},
+		exprUser{Name: "Al", Age: 17},
+		exprUser{Name: "Bo", Age: 20, Status: "y"},
+		map[string]interface{}{"age": 4},
+		map[string]interface{}{"status": "y"},
+		nil,
+	} {
+		assert.Equal(t, fn1(val), fn2(val), "%v", val)
+	}
+}
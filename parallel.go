@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	parallelErrorMsg = "panic processing element %d: %v"
+)
+
+// ParallelError is the error returned by ParallelMap and ParallelFilter when fn panics.
+// Index is the index of the element fn panicked on, and Value is the value passed to panic.
+type ParallelError struct {
+	Index int
+	Value interface{}
+}
+
+// Error is the error interface
+func (p ParallelError) Error() string {
+	return fmt.Sprintf(parallelErrorMsg, p.Index, p.Value)
+}
+
+// parallelApply calls fn(i) for every index of an array or slice using the given number of workers.
+// If workers is 0, runtime.NumCPU() workers are used.
+// Returns a ParallelError for the first panic that occurs, or ctx.Err() if ctx is done before all elements are processed.
+// Once either occurs, no further elements are processed, as each worker checks for cancellation before taking the next index.
+// If ctx is done after all elements are processed, nil is returned.
+func parallelApply(ctx context.Context, n int, workers uint, fn func(int)) error {
+	if workers == 0 {
+		workers = uint(runtime.NumCPU())
+	}
+
+	var (
+		cctx, cancel = context.WithCancel(ctx)
+		indexes      = make(chan int)
+		wg           sync.WaitGroup
+		once         sync.Once
+		firstErr     error
+		processed    int64
+	)
+	defer cancel()
+
+	// Workers process indexes until there are no more, ctx is done, or a panic occurs
+	wg.Add(int(workers))
+	for w := uint(0); w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				if cctx.Err() != nil {
+					return
+				}
+
+				func() {
+					// A panic is detected by fn not completing, as recover returns nil for panic(nil)
+					completed := false
+					defer func() {
+						if r := recover(); !completed {
+							once.Do(func() {
+								firstErr = ParallelError{Index: i, Value: r}
+								cancel()
+							})
+						}
+					}()
+
+					fn(i)
+					completed = true
+					atomic.AddInt64(&processed, 1)
+				}()
+			}
+		}()
+	}
+
+	// Feed indexes until done, cancelled, or a panic occurs
+FEED:
+	for i := 0; i < n; i++ {
+		select {
+		case indexes <- i:
+		case <-cctx.Done():
+			break FEED
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	// Only a done ctx can cut the work short without a panic
+	if processed < int64(n) {
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+// ParallelMap (ctx, arrslc, fn, workers) applies fn to every element of an array or slice using the given number of goroutines.
+// fn is adapted using Map, and if workers is 0, runtime.NumCPU() goroutines are used.
+// The result is a []interface{} of the mapped values in the same order as the input.
+// If fn panics, the first panic is returned as a ParallelError, and the result is nil.
+// If ctx is done before all elements are mapped, ctx.Err() is returned, and the result is nil.
+// Panics if arrslc is not an array or slice.
+func ParallelMap(ctx context.Context, arrslc interface{}, fn interface{}, workers uint) ([]interface{}, error) {
+	rv := arrayOrSlice(arrslc)
+
+	var (
+		mapFn  = Map(fn)
+		n      = rv.Len()
+		result = make([]interface{}, n)
+	)
+
+	if err := parallelApply(ctx, n, workers, func(i int) {
+		result[i] = mapFn(rv.Index(i).Interface())
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ParallelFilter (ctx, arrslc, fn, workers) applies fn to every element of an array or slice using the given number of goroutines.
+// fn is adapted using Filter, and if workers is 0, runtime.NumCPU() goroutines are used.
+// The result is a slice of the array or slice element type, containing the elements fn returned true for,
+// in the same order as the input.
+// If fn panics, the first panic is returned as a ParallelError, and the result is nil.
+// If ctx is done before all elements are filtered, ctx.Err() is returned, and the result is nil.
+// Panics if arrslc is not an array or slice.
+func ParallelFilter(ctx context.Context, arrslc interface{}, fn interface{}, workers uint) (interface{}, error) {
+	rv := arrayOrSlice(arrslc)
+
+	var (
+		filterFn = Filter(fn)
+		n        = rv.Len()
+		keep     = make([]bool, n)
+	)
+
+	if err := parallelApply(ctx, n, workers, func(i int) {
+		keep[i] = filterFn(rv.Index(i).Interface())
+	}); err != nil {
+		return nil, err
+	}
+
+	result := reflect.MakeSlice(reflect.SliceOf(rv.Type().Elem()), 0, n)
+	for i, k := range keep {
+		if k {
+			result = reflect.Append(result, rv.Index(i))
+		}
+	}
+
+	return result.Interface(), nil
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMap(t *testing.T) {
+	ctx := context.Background()
+
+	// Order is preserved
+	res, err := ParallelMap(ctx, []int{1, 2, 3, 4, 5}, func(i int) string { return strconv.Itoa(i * 2) }, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"2", "4", "6", "8", "10"}, res)
+
+	// Array, default workers
+	res, err = ParallelMap(ctx, [2]int{1, 2}, func(i int) int { return i + 1 }, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{2, 3}, res)
+
+	// Empty
+	res, err = ParallelMap(ctx, []int{}, func(i int) int { return i }, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{}, res)
+
+	// Panic is returned as an error with the index
+	res, err = ParallelMap(ctx, []int{1, 2, 3}, func(i int) int {
+		if i == 2 {
+			panic("two")
+		}
+		return i
+	}, 1)
+	assert.Nil(t, res)
+	assert.Equal(t, ParallelError{Index: 1, Value: "two"}, err)
+	assert.Equal(t, "panic processing element 1: two", err.Error())
+
+	// panic(nil) is still a panic
+	res, err = ParallelMap(ctx, []int{1, 2}, func(int) int { panic(nil) }, 1)
+	assert.Nil(t, res)
+	assert.Equal(t, ParallelError{Index: 0, Value: nil}, err)
+
+	// Cancelled context
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	res, err = ParallelMap(cctx, []int{1, 2, 3}, func(i int) int { return i }, 1)
+	assert.Nil(t, res)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	// No further elements are processed once ctx is done or a panic occurs
+	var calls int
+	cctx, cancel = context.WithCancel(ctx)
+	res, err = ParallelMap(cctx, []int{1, 2, 3}, func(i int) int {
+		calls++
+		cancel()
+		return i
+	}, 1)
+	assert.Nil(t, res)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	_, err = ParallelMap(ctx, []int{1, 2, 3}, func(i int) int {
+		calls++
+		panic("stop")
+	}, 1)
+	assert.Equal(t, ParallelError{Index: 0, Value: "stop"}, err)
+	assert.Equal(t, 1, calls)
+
+	// A ctx done after every element is processed is not an error
+	cctx, cancel = context.WithCancel(ctx)
+	res, err = ParallelMap(cctx, []int{1, 2, 3}, func(i int) int {
+		if i == 3 {
+			cancel()
+		}
+		return i
+	}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1, 2, 3}, res)
+
+	func() {
+		defer func() {
+			assert.Equal(t, indexOfErrorMsg, recover())
+		}()
+
+		ParallelMap(ctx, 5, func(i int) int { return i }, 1)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestParallelFilter(t *testing.T) {
+	ctx := context.Background()
+
+	// Order is preserved, result has element type
+	res, err := ParallelFilter(ctx, []int{1, 2, 3, 4, 5, 6}, func(i int) bool { return i%2 == 0 }, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 4, 6}, res)
+
+	// Array, default workers
+	res, err = ParallelFilter(ctx, [3]string{"a", "bb", "c"}, func(s string) bool { return len(s) == 1 }, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "c"}, res)
+
+	// Panic is returned as an error with the index
+	res, err = ParallelFilter(ctx, []int{1, 2, 3}, func(i int) bool {
+		if i == 3 {
+			panic("three")
+		}
+		return true
+	}, 2)
+	assert.Nil(t, res)
+	assert.Equal(t, ParallelError{Index: 2, Value: "three"}, err)
+
+	// Cancelled context
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	res, err = ParallelFilter(cctx, []int{1, 2, 3}, func(int) bool { return true }, 1)
+	assert.Nil(t, res)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	func() {
+		defer func() {
+			assert.Equal(t, indexOfErrorMsg, recover())
+		}()
+
+		ParallelFilter(ctx, nil, func(int) bool { return true }, 1)
+		assert.Fail(t, "must panic")
+	}()
+}
@@ -0,0 +1,375 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	predicateOpErrorMsg    = "predicate op %q is not a valid op"
+	predicateArgsErrorMsg  = "predicate op %q requires %d args, not %d"
+	predicateNilErrorMsg   = "predicate op %q has a nil arg"
+	predicatePathErrorMsg  = "predicate op %q requires a path of names separated by dots, not %q"
+	predicateValueErrorMsg = "predicate op %q requires a lessable value, not %v"
+	predicateExtraErrorMsg = "predicate op %q does not accept a %s"
+	predicateCostErrorMsg  = "predicate op %q has a negative cost %v"
+)
+
+// PredicateOp is the operation of a Predicate node
+type PredicateOp string
+
+const (
+	// OpAnd is true if all args are true, or there are no args
+	OpAnd PredicateOp = "and"
+
+	// OpOr is true if any arg is true, and false if there are no args
+	OpOr PredicateOp = "or"
+
+	// OpNot is true if its single arg is false
+	OpNot PredicateOp = "not"
+
+	// OpEqualTo is true if the value being tested is equal to Value
+	OpEqualTo PredicateOp = "eq"
+
+	// OpLessThan is true if the value being tested is < Value
+	OpLessThan PredicateOp = "lt"
+
+	// OpLessThanEquals is true if the value being tested is <= Value
+	OpLessThanEquals PredicateOp = "lte"
+
+	// OpGreaterThan is true if the value being tested is > Value
+	OpGreaterThan PredicateOp = "gt"
+
+	// OpGreaterThanEquals is true if the value being tested is >= Value
+	OpGreaterThanEquals PredicateOp = "gte"
+
+	// OpField is true if its single arg is true for the field at Path of the value being tested
+	OpField PredicateOp = "field"
+
+	// OpTrue is always true
+	OpTrue PredicateOp = "true"
+
+	// OpFalse is always false
+	OpFalse PredicateOp = "false"
+)
+
+var (
+	// predicateOrderings maps each ordering op to the func that implements it
+	predicateOrderings = map[PredicateOp]func(interface{}, ...CompareOption) func(interface{}) bool{
+		OpLessThan:          IsLessThan,
+		OpLessThanEquals:    IsLessThanEquals,
+		OpGreaterThan:       IsGreaterThan,
+		OpGreaterThanEquals: IsGreaterThanEquals,
+	}
+
+	// predicateNames maps each op to the name it is printed as
+	predicateNames = map[PredicateOp]string{
+		OpAnd:               "And",
+		OpOr:                "Or",
+		OpNot:               "Not",
+		OpEqualTo:           "EqualTo",
+		OpLessThan:          "LessThan",
+		OpLessThanEquals:    "LessThanEquals",
+		OpGreaterThan:       "GreaterThan",
+		OpGreaterThanEquals: "GreaterThanEquals",
+		OpField:             "Field",
+		OpTrue:              "True",
+		OpFalse:             "False",
+	}
+)
+
+// Predicate is a node of an inspectable predicate tree, which can be evaluated as a filter, printed, and marshaled to and from JSON.
+// Op determines which other fields are used:
+//   - OpAnd and OpOr use any number of Args
+//   - OpNot uses one Arg
+//   - OpEqualTo and the ordering ops use Value, which must be lessable for the ordering ops
+//   - OpField uses Path and one Arg
+//   - OpTrue and OpFalse use nothing
+//
+// Cost is an optional hint of the relative cost of evaluating the node, used by Optimize to evaluate cheaper nodes first.
+// If Cost is 0, the cost is estimated as described by Optimize.
+// Values that are numbers are compared using NumericExact, so a Value unmarshaled from JSON as a float64 can be compared to an int.
+// Other values are compared the same way as EqualTo and IsLessThan and friends.
+// A Value that is a string, number, or bool is only compared to a value of the same kind, as described by CompileExpr.
+// Only values of types that JSON can represent survive marshaling: strings, numbers, bools, and nil.
+type Predicate struct {
+	Op    PredicateOp  `json:"op"`
+	Args  []*Predicate `json:"args,omitempty"`
+	Path  string       `json:"path,omitempty"`
+	Value interface{}  `json:"value,omitempty"`
+	Cost  float64      `json:"cost,omitempty"`
+}
+
+// PredAnd (preds) returns a Predicate that is true if all the preds are true
+func PredAnd(preds ...*Predicate) *Predicate {
+	return &Predicate{Op: OpAnd, Args: preds}
+}
+
+// PredOr (preds) returns a Predicate that is true if any of the preds is true
+func PredOr(preds ...*Predicate) *Predicate {
+	return &Predicate{Op: OpOr, Args: preds}
+}
+
+// PredNot (pred) returns a Predicate that is true if pred is false
+func PredNot(pred *Predicate) *Predicate {
+	return &Predicate{Op: OpNot, Args: []*Predicate{pred}}
+}
+
+// PredEqualTo (val) returns a Predicate that is true if the value being tested is equal to val
+func PredEqualTo(val interface{}) *Predicate {
+	return &Predicate{Op: OpEqualTo, Value: val}
+}
+
+// PredLessThan (val) returns a Predicate that is true if the value being tested is < val
+func PredLessThan(val interface{}) *Predicate {
+	return &Predicate{Op: OpLessThan, Value: val}
+}
+
+// PredLessThanEquals (val) returns a Predicate that is true if the value being tested is <= val
+func PredLessThanEquals(val interface{}) *Predicate {
+	return &Predicate{Op: OpLessThanEquals, Value: val}
+}
+
+// PredGreaterThan (val) returns a Predicate that is true if the value being tested is > val
+func PredGreaterThan(val interface{}) *Predicate {
+	return &Predicate{Op: OpGreaterThan, Value: val}
+}
+
+// PredGreaterThanEquals (val) returns a Predicate that is true if the value being tested is >= val
+func PredGreaterThanEquals(val interface{}) *Predicate {
+	return &Predicate{Op: OpGreaterThanEquals, Value: val}
+}
+
+// PredField (path, pred) returns a Predicate that is true if pred is true for the field at path of the value being tested.
+// The path is resolved the same way as a field in CompileExpr, so it may contain struct fields and map keys.
+func PredField(path string, pred *Predicate) *Predicate {
+	return &Predicate{Op: OpField, Path: path, Args: []*Predicate{pred}}
+}
+
+// PredTrue returns a Predicate that is always true
+func PredTrue() *Predicate {
+	return &Predicate{Op: OpTrue}
+}
+
+// PredFalse returns a Predicate that is always false
+func PredFalse() *Predicate {
+	return &Predicate{Op: OpFalse}
+}
+
+// isIdentifier returns true if name is a letter or underscore followed by any number of letters, digits, and underscores
+func isIdentifier(name string) bool {
+	for i, r := range name {
+		if !unicode.IsLetter(r) && (r != '_') && ((i == 0) || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+
+	return name != ""
+}
+
+// validate returns an error if this node is invalid, without validating the args
+func (p *Predicate) validate() error {
+	numArgs := -1
+	switch p.Op {
+	case OpAnd, OpOr:
+	case OpNot:
+		numArgs = 1
+	case OpEqualTo, OpTrue, OpFalse:
+		numArgs = 0
+	case OpLessThan, OpLessThanEquals, OpGreaterThan, OpGreaterThanEquals:
+		numArgs = 0
+		if !IsLessable(p.Value) {
+			return fmt.Errorf(predicateValueErrorMsg, p.Op, p.Value)
+		}
+	case OpField:
+		numArgs = 1
+		for _, name := range strings.Split(p.Path, ".") {
+			if !isIdentifier(name) {
+				return fmt.Errorf(predicatePathErrorMsg, p.Op, p.Path)
+			}
+		}
+	default:
+		return fmt.Errorf(predicateOpErrorMsg, p.Op)
+	}
+
+	if (numArgs >= 0) && (len(p.Args) != numArgs) {
+		return fmt.Errorf(predicateArgsErrorMsg, p.Op, numArgs, len(p.Args))
+	}
+
+	for _, arg := range p.Args {
+		if arg == nil {
+			return fmt.Errorf(predicateNilErrorMsg, p.Op)
+		}
+	}
+
+	if (p.Op != OpField) && (p.Path != "") {
+		return fmt.Errorf(predicateExtraErrorMsg, p.Op, "path")
+	}
+
+	if ((numArgs != 0) || (p.Op == OpTrue) || (p.Op == OpFalse)) && (p.Value != nil) {
+		return fmt.Errorf(predicateExtraErrorMsg, p.Op, "value")
+	}
+
+	if p.Cost < 0 {
+		return fmt.Errorf(predicateCostErrorMsg, p.Op, p.Cost)
+	}
+
+	return nil
+}
+
+// Validate returns an error describing the first invalid node of the tree, or nil if the tree is valid
+func (p *Predicate) Validate() error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+
+	for _, arg := range p.Args {
+		if err := arg.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Filter returns a func(interface{}) bool that evaluates the tree.
+// And, Or, and Not are evaluated using the funcs of the same name, so they short-circuit.
+// A field that does not exist, or is reached through a nil pointer, makes the OpField node false.
+// The other nodes are labeled with Named as they print, so they can be identified in a Trace returned by Explain.
+// Panics if the tree is invalid.
+func (p *Predicate) Filter() func(interface{}) bool {
+	PanicE(p.validate())
+
+	switch p.Op {
+	case OpAnd, OpOr:
+		fns := make([]interface{}, len(p.Args))
+		for i, arg := range p.Args {
+			fns[i] = arg.Filter()
+		}
+
+		if p.Op == OpAnd {
+			return And(fns...)
+		}
+
+		return Or(fns...)
+
+	case OpNot:
+		return Not(p.Args[0].Filter())
+
+	case OpEqualTo:
+		return Named(p.String(), exprEqualTo(p.Value))
+
+	case OpTrue, OpFalse:
+		res := p.Op == OpTrue
+		return Named(p.String(), func(interface{}) bool {
+			return res
+		})
+
+	case OpField:
+		names := strings.Split(p.Path, ".")
+
+		return newTraceNode(fmt.Sprintf("Field(%q)", p.Path), []interface{}{p.Args[0].Filter()}, func(arg interface{}, fns []func(interface{}) bool) bool {
+			rv, ok := fieldPathValue(arg, names, exprField)
+			return ok && fns[0](rv.Interface())
+		})
+	}
+
+	return Named(p.String(), exprOrdering(predicateOrderings[p.Op], p.Value))
+}
+
+// formatValue formats a Value, quoting strings
+func formatValue(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return fmt.Sprintf("%q", v)
+	}
+
+	return fmt.Sprintf("%v", val)
+}
+
+// format writes the tree to sb, with each arg of And and Or on a separate line if indent is non-empty
+func (p *Predicate) format(sb *strings.Builder, indent, prefix string) {
+	name, haveIt := predicateNames[p.Op]
+	if !haveIt {
+		name = string(p.Op)
+	}
+
+	sb.WriteString(name)
+	sb.WriteString("(")
+
+	switch p.Op {
+	case OpEqualTo, OpLessThan, OpLessThanEquals, OpGreaterThan, OpGreaterThanEquals:
+		sb.WriteString(formatValue(p.Value))
+
+	case OpField:
+		fmt.Fprintf(sb, "%q, ", p.Path)
+		fallthrough
+
+	case OpNot:
+		for _, arg := range p.Args {
+			arg.format(sb, indent, prefix)
+		}
+
+	default:
+		argPrefix := prefix + indent
+		for i, arg := range p.Args {
+			if indent == "" {
+				if i > 0 {
+					sb.WriteString(", ")
+				}
+
+				arg.format(sb, indent, argPrefix)
+				continue
+			}
+
+			sb.WriteString("\n")
+			sb.WriteString(argPrefix)
+			arg.format(sb, indent, argPrefix)
+			sb.WriteString(",")
+		}
+
+		if (indent != "") && (len(p.Args) > 0) {
+			sb.WriteString("\n")
+			sb.WriteString(prefix)
+		}
+	}
+
+	sb.WriteString(")")
+}
+
+// String returns the tree on one line, eg And(Field("Age", GreaterThanEquals(18)), Not(EqualTo("x")))
+func (p *Predicate) String() string {
+	var sb strings.Builder
+	p.format(&sb, "", "")
+
+	return sb.String()
+}
+
+// Indent returns the tree on multiple lines, with each arg of And and Or on its own line, indented by indent for each level
+func (p *Predicate) Indent(indent string) string {
+	var sb strings.Builder
+	p.format(&sb, indent, "")
+
+	return sb.String()
+}
+
+// UnmarshalJSON unmarshals a node from JSON, and returns an error if the node is invalid.
+// Numbers are unmarshaled as float64.
+func (p *Predicate) UnmarshalJSON(data []byte) error {
+	// Unmarshal into a type with the same fields and no methods, to avoid recursing into this method
+	type predicate Predicate
+
+	var node predicate
+	if err := json.Unmarshal(data, &node); err != nil {
+		return err
+	}
+
+	*p = Predicate(node)
+	return p.validate()
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredicateFilter(t *testing.T) {
+	var (
+		al = exprUser{Name: "Al", Age: 20, Status: "x", Address: &fieldAddress{City: "Ottawa"}}
+		bo = &exprUser{Name: "Bo", Age: 15, Status: "z"}
+		cy = map[string]interface{}{"name": "Cy", "age": 30, "status": "y"}
+
+		pred = PredOr(
+			PredAnd(
+				PredField("age", PredGreaterThanEquals(18)),
+				PredField("name", PredNot(PredEqualTo("Cy"))),
+			),
+			PredField("status", PredOr(PredEqualTo("y"), PredEqualTo("z"))),
+		)
+		fn = pred.Filter()
+	)
+
+	assert.True(t, fn(al))
+	assert.True(t, fn(bo))
+	assert.True(t, fn(cy))
+	assert.False(t, fn(nil))
+
+	for pred, expected := range map[*Predicate][3]bool{
+		PredField("Age", PredLessThan(20)):               {false, true, false},
+		PredField("age", PredLessThanEquals(20.0)):       {true, true, false},
+		PredField("age", PredGreaterThan(uint(20))):      {false, false, true},
+		PredField("age", PredEqualTo(30)):                {false, false, true},
+		PredField("address.city", PredEqualTo("Ottawa")): {true, false, false},
+		PredField("address", PredEqualTo(nil)):           {false, true, false},
+		PredField("name", PredAnd(PredGreaterThan("B"))): {false, true, true},
+		PredNot(PredField("missing", PredEqualTo(nil))):  {true, true, true},
+		PredAnd(): {true, true, true},
+		PredOr():  {false, false, false},
+		PredNot(PredField("status", PredLessThanEquals("x"))):            {false, true, true},
+		PredField("age", PredAnd(PredGreaterThan(16), PredLessThan(25))): {true, false, false},
+	} {
+		fn := pred.Filter()
+		assert.Equal(t, expected, [3]bool{fn(al), fn(bo), fn(cy)}, pred.String())
+	}
+
+	// Without a field, the value itself is tested
+	assert.True(t, PredAnd(PredGreaterThan(1), PredLessThan(3)).Filter()(2))
+}
+
+func TestPredicateValidate(t *testing.T) {
+	for pred, expected := range map[*Predicate]string{
+		{Op: "xor"}:                                    fmt.Sprintf(predicateOpErrorMsg, "xor"),
+		{Op: OpNot}:                                    fmt.Sprintf(predicateArgsErrorMsg, OpNot, 1, 0),
+		PredField("a", nil):                            fmt.Sprintf(predicateNilErrorMsg, OpField),
+		PredAnd(PredEqualTo(1), nil):                   fmt.Sprintf(predicateNilErrorMsg, OpAnd),
+		PredField("a..b", PredEqualTo(1)):              fmt.Sprintf(predicatePathErrorMsg, OpField, "a..b"),
+		PredField("", PredEqualTo(1)):                  fmt.Sprintf(predicatePathErrorMsg, OpField, ""),
+		PredLessThan(true):                             fmt.Sprintf(predicateValueErrorMsg, OpLessThan, true),
+		{Op: OpEqualTo, Args: []*Predicate{PredAnd()}}: fmt.Sprintf(predicateArgsErrorMsg, OpEqualTo, 0, 1),
+		{Op: OpAnd, Path: "a"}:                         fmt.Sprintf(predicateExtraErrorMsg, OpAnd, "path"),
+		{Op: OpAnd, Value: 1}:                          fmt.Sprintf(predicateExtraErrorMsg, OpAnd, "value"),
+		PredOr(PredNot(PredGreaterThan(nil))):          fmt.Sprintf(predicateValueErrorMsg, OpGreaterThan, nil),
+	} {
+		assert.Equal(t, expected, pred.Validate().Error())
+
+		func() {
+			defer func() {
+				assert.Equal(t, expected, recover())
+			}()
+
+			pred.Filter()
+			assert.Fail(t, "must panic")
+		}()
+	}
+
+	assert.Nil(t, PredOr(PredField("a_1.B", PredEqualTo(nil))).Validate())
+}
+
+func TestPredicateString(t *testing.T) {
+	pred := PredOr(
+		PredAnd(
+			PredField("age", PredGreaterThanEquals(18)),
+			PredField("name", PredNot(PredEqualTo("Cy"))),
+		),
+		PredField("status", PredEqualTo(nil)),
+		PredAnd(),
+	)
+
+	assert.Equal(
+		t,
+		`Or(And(Field("age", GreaterThanEquals(18)), Field("name", Not(EqualTo("Cy")))), Field("status", EqualTo(nil)), And())`,
+		pred.String(),
+	)
+
+	assert.Equal(
+		t,
+		`Or(
+  And(
+    Field("age", GreaterThanEquals(18)),
+    Field("name", Not(EqualTo("Cy"))),
+  ),
+  Field("status", EqualTo(nil)),
+  And(),
+)`,
+		pred.Indent("  "),
+	)
+
+	assert.Equal(t, "LessThan(1.5)", PredLessThan(1.5).Indent("  "))
+}
+
+func TestPredicateJSON(t *testing.T) {
+	pred := PredOr(
+		PredAnd(
+			PredField("age", PredGreaterThanEquals(18)),
+			PredField("name", PredNot(PredEqualTo("Cy"))),
+		),
+		PredField("status", PredEqualTo(nil)),
+		PredField("active", PredEqualTo(false)),
+	)
+
+	data, err := json.Marshal(pred)
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		`{"op":"or","args":[`+
+			`{"op":"and","args":[`+
+			`{"op":"field","args":[{"op":"gte","value":18}],"path":"age"},`+
+			`{"op":"field","args":[{"op":"not","args":[{"op":"eq","value":"Cy"}]}],"path":"name"}]},`+
+			`{"op":"field","args":[{"op":"eq"}],"path":"status"},`+
+			`{"op":"field","args":[{"op":"eq","value":false}],"path":"active"}]}`,
+		string(data),
+	)
+
+	var unmarshaled *Predicate
+	assert.Nil(t, json.Unmarshal(data, &unmarshaled))
+	assert.Equal(t, pred.String(), unmarshaled.String())
+	assert.Equal(t, 18.0, unmarshaled.Args[0].Args[0].Args[0].Value)
+
+	// Numbers unmarshaled as float64 still compare to ints
+	var (
+		fn1 = pred.Filter()
+		fn2 = unmarshaled.Filter()
+	)
+	for _, val := range []interface{}{
+		exprUser{Name: "Al", Age: 20},
+		exprUser{Name: "Cy", Age: 20, Active: true, Status: "x"},
+		map[string]interface{}{"age": 17, "active": false},
+		map[string]interface{}{"age": 17, "active": true},
+	} {
+		assert.Equal(t, fn1(val), fn2(val))
+	}
+
+	// Invalid nodes
+	assert.Equal(t, fmt.Sprintf(predicateArgsErrorMsg, OpNot, 1, 2), json.Unmarshal([]byte(`{"op":"not","args":[{"op":"and"},{"op":"or"}]}`), &unmarshaled).Error())
+	assert.Equal(t, fmt.Sprintf(predicateOpErrorMsg, "nope"), json.Unmarshal([]byte(`{"op":"and","args":[{"op":"nope"}]}`), &unmarshaled).Error())
+	assert.NotNil(t, json.Unmarshal([]byte(`{"op":1}`), &unmarshaled))
+}
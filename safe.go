@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+)
+
+const (
+	panicErrorMsg        = "panic: %v"
+	safeHandlerErrorMsg  = "handler must be a non-nil func(error)"
+	catchHandlerErrorMsg = "handler must be a non-nil func"
+	catchAsErrorMsg      = "target must be a non-nil pointer to an interface or a type that implements error"
+	catchTypeErrorMsg    = "val cannot be nil"
+	finallyErrorMsg      = "finally must be a non-nil func()"
+)
+
+// PanicError is the error a Safe func returns when the func it wraps panics.
+// Value is the value passed to panic, and Stack is the stack trace of the goroutine at the time of the panic.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+// Error is the error interface
+func (p PanicError) Error() string {
+	return fmt.Sprintf(panicErrorMsg, p.Value)
+}
+
+// Unwrap returns Value if it is an error, else nil, so that errors.Is and errors.As can examine the value passed to panic
+func (p PanicError) Unwrap() error {
+	if err, isa := p.Value.(error); isa {
+		return err
+	}
+
+	return nil
+}
+
+// safeCall calls fn, and returns a PanicError if it panics.
+// A panic is detected by fn not completing, as recover returns nil for panic(nil), which is then the Value.
+func safeCall(fn func()) (err error) {
+	completed := false
+	defer func() {
+		if r := recover(); !completed {
+			err = PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	fn()
+	completed = true
+	return
+}
+
+// SafeFilter (fn, def) adapts a func(any) bool into a func(interface{}) bool that returns def if fn panics.
+// fn is adapted by Filter, so the func also returns def if the arg cannot be converted to the type fn accepts.
+// Panics if fn is not a valid filter.
+func SafeFilter(fn interface{}, def bool) func(interface{}) bool {
+	adaptedFn := Filter(fn)
+
+	return func(arg interface{}) bool {
+		res := def
+		safeCall(func() {
+			res = adaptedFn(arg)
+		})
+
+		return res
+	}
+}
+
+// SafeMap (fn) adapts a func(any) any into a func(interface{}) (interface{}, error) that returns a PanicError if fn panics.
+// fn is adapted by Map, so the func also returns a PanicError if the arg cannot be converted to the type fn accepts.
+// Panics if fn is not a valid map func.
+func SafeMap(fn interface{}) func(interface{}) (interface{}, error) {
+	adaptedFn := Map(fn)
+
+	return func(arg interface{}) (interface{}, error) {
+		var res interface{}
+		if err := safeCall(func() {
+			res = adaptedFn(arg)
+		}); err != nil {
+			return nil, err
+		}
+
+		return res, nil
+	}
+}
+
+// SafeConsumer (fn, handler) adapts a func(any) into a func(interface{}) that passes a PanicError to handler if fn panics.
+// fn is adapted by Consumer, so the handler is also called if the arg cannot be converted to the type fn accepts.
+// A panic in handler is not recovered.
+// Panics if fn is not a valid consumer, or handler is nil.
+func SafeConsumer(fn interface{}, handler func(error)) func(interface{}) {
+	adaptedFn := Consumer(fn)
+	PanicBM(handler != nil, safeHandlerErrorMsg)
+
+	return func(arg interface{}) {
+		if err := safeCall(func() {
+			adaptedFn(arg)
+		}); err != nil {
+			handler(err)
+		}
+	}
+}
+
+// Try (fn) calls fn, and returns a PanicError if it panics, else nil.
+// fn may be a func(), or any func that Supplier accepts, in which case the result is discarded.
+// Panics if fn is not a func() or a valid supplier.
+func Try(fn interface{}) error {
+	if f, isa := fn.(func()); isa {
+		return safeCall(f)
+	}
+
+	adaptedFn := Supplier(fn)
+
+	return safeCall(func() {
+		adaptedFn()
+	})
+}
+
+// TryV (fn) calls fn, and returns the result and nil, or nil and a PanicError if it panics.
+// fn may be any func that Supplier accepts.
+// Panics if fn is not a valid supplier.
+func TryV(fn interface{}) (interface{}, error) {
+	var (
+		adaptedFn = Supplier(fn)
+		res       interface{}
+	)
+
+	if err := safeCall(func() {
+		res = adaptedFn()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CatchClause is a clause of Catch that handles the errors it matches.
+// Clauses are created by CatchIs, CatchAs, CatchType, and CatchAll, and can be made to rethrow with Rethrow.
+type CatchClause struct {
+	match   func(err error, val interface{}) bool
+	handler func(err error, val interface{})
+	rethrow bool
+}
+
+// CatchIs (target, handler) returns a CatchClause that calls handler with the error if errors.Is(error, target) is true.
+// A PanicError unwraps to the value passed to panic if it is an error, so target can match the panic value or any error it wraps.
+// A panic with a string, such as PanicE and PanicVE raise, matches if the string is the message of target.
+// Panics if handler is nil.
+func CatchIs(target error, handler func(error)) CatchClause {
+	PanicBM(handler != nil, catchHandlerErrorMsg)
+
+	return CatchClause{
+		match: func(err error, val interface{}) bool {
+			if str, isa := val.(string); isa && (target != nil) {
+				return str == target.Error()
+			}
+
+			return errors.Is(err, target)
+		},
+		handler: func(err error, _ interface{}) {
+			handler(err)
+		},
+	}
+}
+
+// CatchAs (target, handler) returns a CatchClause that calls handler with the error if errors.As(error, target) is true,
+// in which case target has been set to the matching error in the chain.
+// Panics if target is not a non-nil pointer to an interface or a type that implements error, or handler is nil.
+func CatchAs(target interface{}, handler func(error)) CatchClause {
+	rv := reflect.ValueOf(target)
+	PanicBM(
+		(rv.Kind() == reflect.Ptr) &&
+			(!rv.IsNil()) &&
+			((rv.Elem().Kind() == reflect.Interface) || rv.Type().Elem().Implements(reflect.TypeOf((*error)(nil)).Elem())),
+		catchAsErrorMsg,
+	)
+	PanicBM(handler != nil, catchHandlerErrorMsg)
+
+	return CatchClause{
+		match: func(err error, _ interface{}) bool {
+			return errors.As(err, target)
+		},
+		handler: func(err error, _ interface{}) {
+			handler(err)
+		},
+	}
+}
+
+// CatchType (val, handler) returns a CatchClause that calls handler with the value if it is assignable to the type of val.
+// The value is the value passed to panic for a PanicError, else the error itself.
+// This allows catching values that are not errors, eg CatchType("", handler) catches panics with a string.
+// Panics if val is nil, or handler is nil.
+func CatchType(val interface{}, handler func(interface{})) CatchClause {
+	PanicBM(val != nil, catchTypeErrorMsg)
+	PanicBM(handler != nil, catchHandlerErrorMsg)
+
+	typ := reflect.TypeOf(val)
+
+	return CatchClause{
+		match: func(_ error, val interface{}) bool {
+			return (val != nil) && reflect.TypeOf(val).AssignableTo(typ)
+		},
+		handler: func(_ error, val interface{}) {
+			handler(val)
+		},
+	}
+}
+
+// CatchAll (handler) returns a CatchClause that calls handler with any error.
+// Panics if handler is nil.
+func CatchAll(handler func(error)) CatchClause {
+	PanicBM(handler != nil, catchHandlerErrorMsg)
+
+	return CatchClause{
+		match: func(error, interface{}) bool {
+			return true
+		},
+		handler: func(err error, _ interface{}) {
+			handler(err)
+		},
+	}
+}
+
+// Rethrow (clause) returns a copy of clause that panics after calling the handler.
+// The panic value is the value passed to the original panic for a PanicError, else the error itself.
+// The stack trace of the original panic is only available to the handler.
+func Rethrow(clause CatchClause) CatchClause {
+	clause.rethrow = true
+	return clause
+}
+
+// Catch (err, clauses) calls the handler of the first clause that matches err, and returns nil.
+// A zero value CatchClause matches nothing. If no clause matches, err is returned, so it can be handled by another Catch or returned to the caller.
+// Returns nil if err is nil.
+// Panics if the matching clause rethrows.
+func Catch(err error, clauses ...CatchClause) error {
+	if err == nil {
+		return nil
+	}
+
+	var val interface{} = err
+	if perr, isa := err.(PanicError); isa {
+		val = perr.Value
+	}
+
+	for _, clause := range clauses {
+		if (clause.match != nil) && clause.match(err, val) {
+			clause.handler(err, val)
+
+			if clause.rethrow {
+				panic(val)
+			}
+
+			return nil
+		}
+	}
+
+	return err
+}
+
+// TryCatch (fn, clauses) is Catch(Try(fn), clauses...)
+func TryCatch(fn interface{}, clauses ...CatchClause) error {
+	return Catch(Try(fn), clauses...)
+}
+
+// TryCatchFinally (fn, finally, clauses) is Catch(Try(fn), clauses...), where finally is called afterwards even if a clause rethrows.
+// Panics if finally is nil.
+func TryCatchFinally(fn interface{}, finally func(), clauses ...CatchClause) error {
+	PanicBM(finally != nil, finallyErrorMsg)
+	defer finally()
+
+	return Catch(Try(fn), clauses...)
+}
@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errSafe = errors.New("safe")
+
+func safeDivide(i int) int {
+	return 10 / i
+}
+
+func TestPanicError(t *testing.T) {
+	err := safeCall(func() { panic("boom") })
+	assert.Equal(t, "panic: boom", err.Error())
+
+	perr, isa := err.(PanicError)
+	assert.True(t, isa)
+	assert.Equal(t, "boom", perr.Value)
+	assert.Nil(t, perr.Unwrap())
+
+	// The stack includes the func that panicked
+	assert.True(t, strings.Contains(string(perr.Stack), "TestPanicError"))
+
+	// An error value is unwrapped
+	err = safeCall(func() { panic(fmt.Errorf("wrapped: %w", errSafe)) })
+	assert.True(t, errors.Is(err, errSafe))
+
+	// No panic
+	assert.Nil(t, safeCall(func() {}))
+
+	// panic(nil) is still a panic
+	err = safeCall(func() { panic(nil) })
+	assert.NotNil(t, err)
+	_, isa = err.(PanicError)
+	assert.True(t, isa)
+
+	assert.False(t, SafeFilter(func(int) bool { panic(nil) }, false)(1))
+	assert.True(t, SafeFilter(func(int) bool { panic(nil) }, true)(1))
+
+	res, err := SafeMap(func(int) int { panic(nil) })(1)
+	assert.Nil(t, res)
+	assert.NotNil(t, err)
+
+	var handled error
+	SafeConsumer(func(int) { panic(nil) }, func(err error) { handled = err })(1)
+	assert.NotNil(t, handled)
+
+	assert.NotNil(t, Try(func() { panic(nil) }))
+}
+
+func TestSafeFilter(t *testing.T) {
+	fn := SafeFilter(func(i int) bool { return safeDivide(i) > 2 }, true)
+	assert.True(t, fn(2))
+	assert.False(t, fn(5))
+	assert.True(t, fn(0))
+
+	// Arg not convertible
+	assert.True(t, fn("x"))
+
+	func() {
+		defer func() {
+			assert.Equal(t, filterErrorMsg, recover())
+		}()
+
+		SafeFilter(1, false)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestSafeMap(t *testing.T) {
+	fn := SafeMap(safeDivide)
+
+	res, err := fn(2)
+	assert.Equal(t, 5, res)
+	assert.Nil(t, err)
+
+	res, err = fn(0)
+	assert.Nil(t, res)
+	assert.Equal(t, "panic: runtime error: integer divide by zero", err.Error())
+	assert.True(t, strings.Contains(string(err.(PanicError).Stack), "safeDivide"))
+
+	func() {
+		defer func() {
+			assert.Equal(t, mapErrorMsg, recover())
+		}()
+
+		SafeMap(1)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestSafeConsumer(t *testing.T) {
+	var (
+		sum  int
+		errs []error
+		fn   = SafeConsumer(func(i int) { sum += safeDivide(i) }, func(err error) { errs = append(errs, err) })
+	)
+
+	fn(2)
+	fn(0)
+	fn(5)
+	assert.Equal(t, 7, sum)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "panic: runtime error: integer divide by zero", errs[0].Error())
+
+	func() {
+		defer func() {
+			assert.Equal(t, safeHandlerErrorMsg, recover())
+		}()
+
+		SafeConsumer(func(int) {}, nil)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, consumerErrorMsg, recover())
+		}()
+
+		SafeConsumer(1, func(error) {})
+		assert.Fail(t, "must panic")
+	}()
+}
+
+type safeError struct {
+	code int
+}
+
+func (e safeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestTry(t *testing.T) {
+	// func()
+	assert.Nil(t, Try(func() {}))
+	assert.Equal(t, "panic: boom", Try(func() { panic("boom") }).Error())
+
+	// Supplier
+	assert.Nil(t, Try(func() int { return 1 }))
+	assert.True(t, errors.Is(Try(func() int { panic(errSafe) }), errSafe))
+
+	res, err := TryV(func() int { return 1 })
+	assert.Equal(t, 1, res)
+	assert.Nil(t, err)
+
+	res, err = TryV(func(...int) int { return safeDivide(0) })
+	assert.Nil(t, res)
+	assert.Equal(t, "panic: runtime error: integer divide by zero", err.Error())
+
+	func() {
+		defer func() {
+			assert.Equal(t, supplierErrorMsg, recover())
+		}()
+
+		Try(1)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, supplierErrorMsg, recover())
+		}()
+
+		TryV(func() {})
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestCatch(t *testing.T) {
+	var caught []interface{}
+	catchErr := func(err error) { caught = append(caught, err) }
+	catchVal := func(val interface{}) { caught = append(caught, val) }
+
+	// Nil error
+	caught = nil
+	assert.Nil(t, Catch(nil, CatchAll(catchErr)))
+	assert.Nil(t, caught)
+
+	// CatchIs matches the panic value and any error it wraps
+	caught = nil
+	err := Try(func() { panic(fmt.Errorf("wrapped: %w", errSafe)) })
+	assert.Nil(t, Catch(err, CatchIs(errors.New("other"), catchErr), CatchIs(errSafe, catchErr)))
+	assert.Equal(t, []interface{}{err}, caught)
+
+	// CatchAs sets the target
+	var target safeError
+	caught = nil
+	err = Try(func() { panic(safeError{3}) })
+	assert.Nil(t, Catch(err, CatchAs(&target, catchErr)))
+	assert.Equal(t, safeError{3}, target)
+	assert.Equal(t, []interface{}{err}, caught)
+
+	// CatchType matches non-error values, and the first matching clause wins
+	caught = nil
+	assert.Nil(t, TryCatch(func() { panic("boom") }, CatchType(0, catchVal), CatchType("", catchVal), CatchAll(catchErr)))
+	assert.Equal(t, []interface{}{"boom"}, caught)
+
+	// Errors that are not a PanicError
+	caught = nil
+	assert.Nil(t, Catch(errSafe, CatchType(errSafe, catchVal)))
+	assert.Equal(t, []interface{}{errSafe}, caught)
+
+	// Unmatched errors are returned, and zero clauses match nothing
+	caught = nil
+	err = Try(func() { panic(1) })
+	assert.Equal(t, err, Catch(err, CatchType("", catchVal), CatchIs(errSafe, catchErr), CatchClause{}))
+	assert.Nil(t, caught)
+
+	// Panics with an error or with the message of the error, as raised by PanicE and PanicVE, are matched by CatchIs
+	caught = nil
+	assert.Nil(t, TryCatch(func() { panic(io.EOF) }, CatchIs(io.EOF, catchErr)))
+	assert.Nil(t, TryCatch(func() { PanicE(io.EOF) }, CatchIs(io.EOF, catchErr)))
+	assert.Nil(t, TryCatch(func() { PanicVE(0, io.EOF) }, CatchIs(io.EOF, catchErr)))
+	assert.Equal(t, 3, len(caught))
+	err = Try(func() { PanicE(io.ErrUnexpectedEOF) })
+	assert.Equal(t, err, Catch(err, CatchIs(io.EOF, catchErr)))
+
+	// Rethrow panics with the original value after handling, and finally is called regardless
+	var finally bool
+	caught = nil
+	func() {
+		defer func() {
+			assert.Equal(t, "boom", recover())
+		}()
+
+		TryCatchFinally(func() { panic("boom") }, func() { finally = true }, Rethrow(CatchType("", catchVal)))
+		assert.Fail(t, "must panic")
+	}()
+	assert.Equal(t, []interface{}{"boom"}, caught)
+	assert.True(t, finally)
+
+	finally = false
+	assert.Nil(t, TryCatchFinally(func() {}, func() { finally = true }))
+	assert.True(t, finally)
+
+	for msg, fn := range map[string]func(){
+		catchHandlerErrorMsg: func() { CatchIs(errSafe, nil) },
+		catchAsErrorMsg:      func() { CatchAs(target, catchErr) },
+		catchTypeErrorMsg:    func() { CatchType(nil, catchVal) },
+		finallyErrorMsg:      func() { TryCatchFinally(func() {}, nil) },
+	} {
+		func() {
+			defer func() {
+				assert.Equal(t, msg, recover())
+			}()
+
+			fn()
+			assert.Fail(t, "must panic")
+		}()
+	}
+}
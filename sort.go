@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"reflect"
+	"sort"
+)
+
+// lessFunc adapts fn into a func(val1, val2 interface{}) bool that returns true if val1 < val2.
+// If fn is a Comparator, the result is fn.Less(), otherwise fn is adapted using SortFunc.
+func lessFunc(fn interface{}) func(val1, val2 interface{}) bool {
+	if c, isa := fn.(Comparator); isa {
+		return c.Less()
+	}
+
+	return SortFunc(fn)
+}
+
+// Sort (slc, fn) sorts a slice of any type in place using fn.
+// fn may be a Comparator or any func SortFunc accepts.
+// The sort is not guaranteed to be stable.
+// Panics if slc is not a slice, or fn is not a Comparator or a func SortFunc accepts.
+func Sort(slc interface{}, fn interface{}) {
+	rv := reflect.ValueOf(slc)
+	PanicBM(rv.Kind() == reflect.Slice, indexOfErrorMsg)
+
+	lt := lessFunc(fn)
+	sort.Slice(slc, func(i, j int) bool {
+		return lt(rv.Index(i).Interface(), rv.Index(j).Interface())
+	})
+}
+
+// StableSort (slc, fn) sorts a slice of any type in place using fn, keeping equal elements in their original order.
+// fn may be a Comparator or any func SortFunc accepts.
+// Panics if slc is not a slice, or fn is not a Comparator or a func SortFunc accepts.
+func StableSort(slc interface{}, fn interface{}) {
+	rv := reflect.ValueOf(slc)
+	PanicBM(rv.Kind() == reflect.Slice, indexOfErrorMsg)
+
+	lt := lessFunc(fn)
+	sort.SliceStable(slc, func(i, j int) bool {
+		return lt(rv.Index(i).Interface(), rv.Index(j).Interface())
+	})
+}
+
+// IsSorted (arrslc, fn) returns true if an array or slice of any type is sorted according to fn.
+// fn may be a Comparator or any func SortFunc accepts.
+// Panics if arrslc is not an array or slice, or fn is not a Comparator or a func SortFunc accepts.
+func IsSorted(arrslc interface{}, fn interface{}) bool {
+	var (
+		rv = arrayOrSlice(arrslc)
+		lt = lessFunc(fn)
+	)
+
+	for i, n := 1, rv.Len(); i < n; i++ {
+		if lt(rv.Index(i).Interface(), rv.Index(i-1).Interface()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BinarySearch (arrslc, val, fn) searches an array or slice sorted according to fn for val.
+// fn may be a Comparator or any func SortFunc accepts.
+// Returns the smallest index i such that !(arrslc[i] < val), and true if arrslc[i] is equal to val.
+// If val is not present, the index is where val would be inserted to keep the array or slice sorted.
+// Panics if arrslc is not an array or slice, or fn is not a Comparator or a func SortFunc accepts.
+func BinarySearch(arrslc interface{}, val interface{}, fn interface{}) (int, bool) {
+	var (
+		rv = arrayOrSlice(arrslc)
+		lt = lessFunc(fn)
+		n  = rv.Len()
+		i  = sort.Search(n, func(i int) bool {
+			return !lt(rv.Index(i).Interface(), val)
+		})
+	)
+
+	return i, (i < n) && !lt(val, rv.Index(i).Interface())
+}
+
+// MinBy (arrslc, fn, optional default) returns the first of the following:
+// 1. the first minimum element of the array or slice according to fn, if the array or slice is not empty
+// 2. default value if provided, converted to array or slice element type
+// 3. zero value of array or slice element type
+// fn may be a Comparator or any func SortFunc accepts.
+// Panics if arrslc is not an array or slice, or fn is not a Comparator or a func SortFunc accepts.
+// Panics if the default value is not convertible to the array or slice element type, even if it is not needed.
+func MinBy(arrslc interface{}, fn interface{}, defalt ...interface{}) interface{} {
+	return extremeBy(arrslc, lessFunc(fn), defalt)
+}
+
+// MaxBy (arrslc, fn, optional default) returns the first of the following:
+// 1. the first maximum element of the array or slice according to fn, if the array or slice is not empty
+// 2. default value if provided, converted to array or slice element type
+// 3. zero value of array or slice element type
+// fn may be a Comparator or any func SortFunc accepts.
+// Panics if arrslc is not an array or slice, or fn is not a Comparator or a func SortFunc accepts.
+// Panics if the default value is not convertible to the array or slice element type, even if it is not needed.
+func MaxBy(arrslc interface{}, fn interface{}, defalt ...interface{}) interface{} {
+	lt := lessFunc(fn)
+
+	return extremeBy(arrslc, func(val1, val2 interface{}) bool { return lt(val2, val1) }, defalt)
+}
+
+// extremeBy returns the first element of an array or slice that no later element is better than, or the default or zero value if empty
+func extremeBy(arrslc interface{}, better func(val1, val2 interface{}) bool, defalt []interface{}) interface{} {
+	var (
+		rv         = arrayOrSlice(arrslc)
+		elementTyp = rv.Type().Elem()
+	)
+
+	// Always ensure if default is provided that it is convertible to element type
+	var rdf reflect.Value
+	if len(defalt) > 0 {
+		rdf = reflect.ValueOf(defalt[0]).Convert(elementTyp)
+	}
+
+	// Return the extreme element if there are any elements
+	if n := rv.Len(); n > 0 {
+		res := rv.Index(0).Interface()
+		for i := 1; i < n; i++ {
+			if elem := rv.Index(i).Interface(); better(elem, res) {
+				res = elem
+			}
+		}
+
+		return res
+	}
+
+	// Else return default if provided
+	if rdf.IsValid() {
+		return rdf.Interface()
+	}
+
+	// Else return zero value of element type
+	return reflect.Zero(elementTyp).Interface()
+}
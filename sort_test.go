@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	slc := []int{3, 1, 2}
+	Sort(slc, IntSortFunc)
+	assert.Equal(t, []int{1, 2, 3}, slc)
+
+	// Adapted less func
+	slc = []int{3, 1, 2}
+	Sort(slc, func(val1, val2 int) bool { return val1 > val2 })
+	assert.Equal(t, []int{3, 2, 1}, slc)
+
+	// Comparator
+	strs := []string{"b", "c", "a"}
+	Sort(strs, ComparatorOf(StringSortFunc).Reversed())
+	assert.Equal(t, []string{"c", "b", "a"}, strs)
+
+	func() {
+		defer func() {
+			assert.Equal(t, indexOfErrorMsg, recover())
+		}()
+
+		Sort([2]int{2, 1}, IntSortFunc)
+		assert.Fail(t, "must panic")
+	}()
+
+	func() {
+		defer func() {
+			assert.Equal(t, sortErrorMsg, recover())
+		}()
+
+		Sort([]int{2, 1}, func(int) bool { return true })
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestStableSort(t *testing.T) {
+	people := []comparatorPerson{
+		{Name: "b", Age: 2},
+		{Name: "a", Age: 1},
+		{Name: "c", Age: 2},
+		{Name: "d", Age: 1},
+	}
+
+	StableSort(people, func(p1, p2 comparatorPerson) bool { return p1.Age < p2.Age })
+	assert.Equal(t, []comparatorPerson{
+		{Name: "a", Age: 1},
+		{Name: "d", Age: 1},
+		{Name: "b", Age: 2},
+		{Name: "c", Age: 2},
+	}, people)
+
+	func() {
+		defer func() {
+			assert.Equal(t, indexOfErrorMsg, recover())
+		}()
+
+		StableSort(nil, IntSortFunc)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestIsSorted(t *testing.T) {
+	assert.True(t, IsSorted([]int{}, IntSortFunc))
+	assert.True(t, IsSorted([]int{1, 1, 2}, IntSortFunc))
+	assert.False(t, IsSorted([]int{1, 3, 2}, IntSortFunc))
+	assert.True(t, IsSorted([3]int{3, 2, 1}, ComparatorOf(IntSortFunc).Reversed()))
+}
+
+func TestBinarySearch(t *testing.T) {
+	slc := []int{1, 3, 5}
+
+	idx, found := BinarySearch(slc, 3, IntSortFunc)
+	assert.Equal(t, 1, idx)
+	assert.True(t, found)
+
+	idx, found = BinarySearch(slc, 4, IntSortFunc)
+	assert.Equal(t, 2, idx)
+	assert.False(t, found)
+
+	idx, found = BinarySearch(slc, 6, IntSortFunc)
+	assert.Equal(t, 3, idx)
+	assert.False(t, found)
+
+	idx, found = BinarySearch([]int{}, 6, IntSortFunc)
+	assert.Equal(t, 0, idx)
+	assert.False(t, found)
+}
+
+func TestMinMaxBy(t *testing.T) {
+	people := []comparatorPerson{
+		{Name: "b", Age: 2},
+		{Name: "a", Age: 1},
+		{Name: "c", Age: 2},
+		{Name: "d", Age: 1},
+	}
+	byAge := ComparatorByKey(func(p comparatorPerson) int { return p.Age }, nil)
+
+	// First of equal elements is returned
+	assert.Equal(t, comparatorPerson{Name: "a", Age: 1}, MinBy(people, byAge))
+	assert.Equal(t, comparatorPerson{Name: "b", Age: 2}, MaxBy(people, byAge))
+
+	// Arrays
+	assert.Equal(t, 1, MinBy([3]int{2, 1, 3}, IntSortFunc))
+	assert.Equal(t, 3, MaxBy([3]int{2, 1, 3}, IntSortFunc))
+
+	// Empty with and without default
+	assert.Equal(t, 0, MinBy([]int{}, IntSortFunc))
+	assert.Equal(t, 5, MaxBy([]int{}, IntSortFunc, int8(5)))
+
+	func() {
+		defer func() {
+			assert.Equal(t, indexOfErrorMsg, recover())
+		}()
+
+		MinBy(5, IntSortFunc)
+		assert.Fail(t, "must panic")
+	}()
+}
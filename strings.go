@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// stringTyp is the reflect.Type of string
+	stringTyp = reflect.TypeOf("")
+)
+
+// foldRune returns the smallest rune in the Unicode simple case folding orbit of r, so that all case variants fold to the same rune
+func foldRune(r rune) rune {
+	smallest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < smallest {
+			smallest = f
+		}
+	}
+
+	return smallest
+}
+
+// normalizeString returns the runes of str with the letters in decompositions decomposed,
+// case folded if opt contains CaseInsensitive, and without nonspacing marks if opt contains IgnoreMarks
+func normalizeString(str string, opt CompareOption) []rune {
+	var (
+		caseInsensitive = opt&CaseInsensitive != 0
+		ignoreMarks     = opt&IgnoreMarks != 0
+		res             = make([]rune, 0, len(str))
+	)
+
+	add := func(r rune) {
+		if ignoreMarks && unicode.Is(unicode.Mn, r) {
+			return
+		}
+
+		if caseInsensitive {
+			r = foldRune(r)
+		}
+
+		res = append(res, r)
+	}
+
+	for _, r := range str {
+		decomposed, haveIt := decompositions[r]
+		if !haveIt {
+			add(r)
+			continue
+		}
+
+		for _, dr := range decomposed {
+			add(dr)
+		}
+	}
+
+	return res
+}
+
+// isASCIIDigit returns true if r is 0 - 9
+func isASCIIDigit(r rune) bool {
+	return (r >= '0') && (r <= '9')
+}
+
+// digitRun returns the run of ASCII digits in runes starting at index i without leading zeroes, and the index after the run
+func digitRun(runes []rune, i int) ([]rune, int) {
+	// Skip leading zeroes
+	for (i < len(runes)-1) && (runes[i] == '0') && isASCIIDigit(runes[i+1]) {
+		i++
+	}
+
+	start := i
+	for (i < len(runes)) && isASCIIDigit(runes[i]) {
+		i++
+	}
+
+	return runes[start:i], i
+}
+
+// compareStrings compares two strings according to the CaseInsensitive, IgnoreMarks, and NaturalOrder options.
+// In natural order, strings whose digit runs only differ by leading zeroes are ordered rune by rune, eg "file01" < "file1",
+// so that only equal strings compare equal.
+// Returns -1, 0, or 1.
+func compareStrings(s1, s2 string, opt CompareOption) int {
+	var (
+		r1      = normalizeString(s1, opt)
+		r2      = normalizeString(s2, opt)
+		natural = opt&NaturalOrder != 0
+	)
+
+	if res := compareRunes(r1, r2, natural); (res != 0) || !natural {
+		return res
+	}
+
+	return compareRunes(r1, r2, false)
+}
+
+// compareRunes compares two normalized strings rune by rune, or with runs of digits compared by numeric value if natural is true.
+// Returns -1, 0, or 1.
+func compareRunes(r1, r2 []rune, natural bool) int {
+	var i, j int
+
+	for (i < len(r1)) && (j < len(r2)) {
+		// In natural order, runs of digits are compared by numeric value: a longer run is larger, otherwise compare digit by digit
+		if natural && isASCIIDigit(r1[i]) && isASCIIDigit(r2[j]) {
+			var d1, d2 []rune
+			d1, i = digitRun(r1, i)
+			d2, j = digitRun(r2, j)
+
+			if res := compareInts(int64(len(d1)), int64(len(d2))); res != 0 {
+				return res
+			}
+
+			for k := range d1 {
+				if res := compareInts(int64(d1[k]), int64(d2[k])); res != 0 {
+					return res
+				}
+			}
+
+			continue
+		}
+
+		if res := compareInts(int64(r1[i]), int64(r2[j])); res != 0 {
+			return res
+		}
+
+		i++
+		j++
+	}
+
+	// The string with runes remaining is greater
+	return compareInts(int64(len(r1)-i), int64(len(r2)-j))
+}
+
+// toString converts arg to a string, the same way Filter converts an arg to the type the func receives
+func toString(arg interface{}) string {
+	if str, isa := arg.(string); isa {
+		return str
+	}
+
+	return reflect.ValueOf(arg).Convert(stringTyp).String()
+}
+
+// HasPrefix (prefix) returns a func(interface{}) bool that returns true if the arg begins with prefix.
+// The arg may be any type convertible to string.
+func HasPrefix(prefix string) func(interface{}) bool {
+	return func(arg interface{}) bool {
+		return strings.HasPrefix(toString(arg), prefix)
+	}
+}
+
+// HasSuffix (suffix) returns a func(interface{}) bool that returns true if the arg ends with suffix.
+// The arg may be any type convertible to string.
+func HasSuffix(suffix string) func(interface{}) bool {
+	return func(arg interface{}) bool {
+		return strings.HasSuffix(toString(arg), suffix)
+	}
+}
+
+// Contains (substr) returns a func(interface{}) bool that returns true if the arg contains substr.
+// The arg may be any type convertible to string.
+func Contains(substr string) func(interface{}) bool {
+	return func(arg interface{}) bool {
+		return strings.Contains(toString(arg), substr)
+	}
+}
+
+// MatchesRegexp (expr) returns a func(interface{}) bool that returns true if the arg contains a match of the regular expression expr.
+// The expression is compiled once, and the arg may be any type convertible to string.
+// Panics if expr is not a valid regular expression.
+func MatchesRegexp(expr string) func(interface{}) bool {
+	re := regexp.MustCompile(expr)
+
+	return func(arg interface{}) bool {
+		return re.MatchString(toString(arg))
+	}
+}
+
+// EqualFold (str) returns a func(interface{}) bool that returns true if the arg is equal to str under Unicode case folding.
+// The arg may be any type convertible to string.
+func EqualFold(str string) func(interface{}) bool {
+	return func(arg interface{}) bool {
+		return strings.EqualFold(toString(arg), str)
+	}
+}
+
+// IsBlank is a func(interface{}) bool that returns true if the arg is empty or only contains Unicode white space.
+// The arg may be any type convertible to string.
+func IsBlank(arg interface{}) bool {
+	return strings.TrimSpace(toString(arg)) == ""
+}
+
+// LengthBetween (minLen, maxLen) returns a func(interface{}) bool that returns true if the number of runes in the arg is >= minLen and <= maxLen.
+// The arg may be any type convertible to string.
+func LengthBetween(minLen, maxLen uint) func(interface{}) bool {
+	return func(arg interface{}) bool {
+		n := uint(utf8.RuneCountInString(toString(arg)))
+		return (n >= minLen) && (n <= maxLen)
+	}
+}
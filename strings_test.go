@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLessThanStringOptions(t *testing.T) {
+	// Default is byte order
+	assert.True(t, IsLessThan("a")("B"))
+
+	// CaseInsensitive
+	lt := LessThan("", CaseInsensitive)
+	assert.False(t, lt("a", "B") == lt("B", "a"))
+	assert.True(t, lt("a", "B"))
+	assert.False(t, lt("A", "a"))
+	assert.False(t, lt("a", "A"))
+	assert.True(t, LessThanEquals("", CaseInsensitive)("ABC", "abc"))
+	assert.True(t, IsGreaterThanEquals("stra\u00dfe", CaseInsensitive)("STRA\u1e9eE"))
+	assert.True(t, lt("ab", "ABC"))
+	assert.False(t, lt("ABC", "ab"))
+
+	// IgnoreMarks
+	lt = LessThan("", IgnoreMarks)
+	assert.False(t, lt("e\u0301", "e"))
+	assert.False(t, lt("e", "e\u0301"))
+	assert.True(t, lt("e\u0301", "f"))
+	assert.True(t, LessThan("", IgnoreMarks, CaseInsensitive)("E\u0301a", "eB"))
+
+	// Precomposed Latin-1 Supplement and Latin Extended-A letters are decomposed
+	eq := func(s1, s2 string, opts ...CompareOption) bool {
+		return LessThanEquals("", opts...)(s1, s2) && LessThanEquals("", opts...)(s2, s1)
+	}
+	assert.True(t, eq("\u00e9", "e\u0301", IgnoreMarks))
+	assert.True(t, eq("\u00e9", "e", IgnoreMarks))
+	assert.True(t, eq("\u00c9", "e", IgnoreMarks, CaseInsensitive))
+	assert.True(t, eq("\u0160koda", "Skoda", IgnoreMarks))
+	assert.True(t, lt("\u00e9", "f"))
+
+	// Composed and decomposed forms are equal with any string option
+	assert.True(t, eq("\u00e9", "e\u0301", CaseInsensitive))
+	assert.True(t, eq("\u00c9", "e\u0301", CaseInsensitive))
+	assert.False(t, eq("\u00e9", "e", CaseInsensitive))
+
+	// Other blocks are not decomposed
+	assert.False(t, eq("\u1ebf", "e", IgnoreMarks))
+
+	// NaturalOrder
+	lt = LessThan("", NaturalOrder)
+	assert.True(t, lt("file2", "file10"))
+	assert.False(t, lt("file10", "file2"))
+	assert.True(t, lt("file2a", "file2b"))
+	assert.True(t, lt("file2", "file2a"))
+	assert.True(t, lt("file002", "file3"))
+	assert.False(t, lt("file3", "file002"))
+
+	// Equal numeric values with different leading zeroes are ordered rune by rune, so distinct strings are not equal
+	assert.True(t, lt("file002", "file2"))
+	assert.False(t, lt("file2", "file002"))
+	assert.True(t, lt("file01", "file1"))
+	assert.False(t, eq("file01", "file1", NaturalOrder))
+	assert.True(t, eq("file01", "File01", NaturalOrder, CaseInsensitive))
+	assert.True(t, lt("file0", "file00a"))
+	assert.True(t, lt("a1b2", "a1b10"))
+	assert.True(t, lt("9", "a"))
+	assert.True(t, LessThan("", NaturalOrder, CaseInsensitive)("File2", "file10"))
+
+	// Named string type
+	type name string
+	assert.True(t, IsLessThan(name("File10"), NaturalOrder, CaseInsensitive)(name("file9")))
+
+	// Sort funcs
+	strs := []string{"file10", "File2", "file1"}
+	Sort(strs, KindSortFunc(reflect.String, NaturalOrder, CaseInsensitive))
+	assert.Equal(t, []string{"file1", "File2", "file10"}, strs)
+
+	Sort(strs, KindSortFuncDesc(reflect.String, NaturalOrder))
+	assert.Equal(t, []string{"file10", "file1", "File2"}, strs)
+}
+
+func TestStringPredicates(t *testing.T) {
+	type name string
+
+	fn := HasPrefix("ab")
+	assert.True(t, fn("abc"))
+	assert.True(t, fn(name("ab")))
+	assert.True(t, fn([]byte("abd")))
+	assert.False(t, fn("ba"))
+
+	fn = HasSuffix("bc")
+	assert.True(t, fn("abc"))
+	assert.False(t, fn(name("cb")))
+
+	fn = Contains("b")
+	assert.True(t, fn("abc"))
+	assert.False(t, fn([]rune("ac")))
+
+	fn = MatchesRegexp(`^[a-z]+[0-9]$`)
+	assert.True(t, fn("abc1"))
+	assert.False(t, fn(name("abc12")))
+
+	fn = EqualFold("Go")
+	assert.True(t, fn("GO"))
+	assert.True(t, fn(name("go")))
+	assert.False(t, fn("goo"))
+
+	fn = IsBlank
+	assert.True(t, fn(""))
+	assert.True(t, fn(" \t\n"))
+	assert.True(t, fn(name(" ")))
+	assert.False(t, fn(" a "))
+
+	fn = LengthBetween(2, 3)
+	assert.False(t, fn("a"))
+	assert.True(t, fn("ab"))
+	assert.True(t, fn("été"))
+	assert.False(t, fn("abcd"))
+
+	// Composable with And, Or, Not
+	fn = And(HasPrefix("a"), Not(IsBlank), LengthBetween(1, 5))
+	assert.True(t, fn("abc"))
+	assert.False(t, fn("abcdef"))
+
+	func() {
+		defer func() {
+			assert.NotNil(t, recover())
+		}()
+
+		MatchesRegexp("[")
+		assert.Fail(t, "must panic")
+	}()
+}
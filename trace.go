@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// Trace is a node of the tree returned by Explain, describing the evaluation of a func(interface{}) bool.
+// Name is the name of the func, which is the label given to Named, the name of a combinator such as And,
+// or the Go name of any other func, eg "main.isAdult" or "gofuncs.EqualTo.func1".
+// Children are the evaluations of the funcs that were combined, in the order they were called.
+// Funcs skipped by short-circuit logic are not called, so they have no Trace.
+type Trace struct {
+	Name     string
+	Input    interface{}
+	Result   bool
+	Children []*Trace
+}
+
+// format writes the trace to sb, indenting each level by two spaces
+func (t *Trace) format(sb *strings.Builder, prefix string) {
+	fmt.Fprintf(sb, "%s%s(%v) = %t\n", prefix, t.Name, t.Input, t.Result)
+
+	for _, child := range t.Children {
+		child.format(sb, prefix+"  ")
+	}
+}
+
+// String returns the trace as one line per node, with each child indented two spaces further than its parent
+func (t *Trace) String() string {
+	var sb strings.Builder
+	t.format(&sb, "")
+
+	return sb.String()
+}
+
+// tracer is passed by Explain as the arg of a traced func, so that it records the evaluation of val as a child of parent
+type tracer struct {
+	val    interface{}
+	parent *Trace
+}
+
+// tracedKey identifies a func value by the address of its closure and its code pointer.
+// A closure address can be reused once the closure is collected, but not by a closure of the same code until its entry is removed.
+type tracedKey struct {
+	closure, code uintptr
+}
+
+// tracedOwner is captured by each traced func, and removes the func from tracedFuncs when it is collected with the func.
+// gen distinguishes the registration of a func from a later one of a func allocated at the same address.
+type tracedOwner struct {
+	key tracedKey
+	gen uint64
+}
+
+var (
+	// tracedFuncs is the registry of funcs that record their own Trace when passed a tracer
+	tracedMutex sync.RWMutex
+	tracedFuncs = map[tracedKey]uint64{}
+	tracedGen   uint64
+)
+
+// funcKey returns the tracedKey of fn
+func funcKey(fn func(interface{}) bool) tracedKey {
+	// A func value is a pointer to its closure, which begins with the code pointer
+	return tracedKey{
+		closure: *(*uintptr)(unsafe.Pointer(&fn)),
+		code:    reflect.ValueOf(fn).Pointer(),
+	}
+}
+
+// registerTraced adds fn to the registry, returning the owner fn must capture so that it is removed when fn is collected
+func registerTraced(fn func(interface{}) bool, owner *tracedOwner) {
+	tracedMutex.Lock()
+	tracedGen++
+	owner.key, owner.gen = funcKey(fn), tracedGen
+	tracedFuncs[owner.key] = owner.gen
+	tracedMutex.Unlock()
+
+	runtime.SetFinalizer(owner, func(owner *tracedOwner) {
+		tracedMutex.Lock()
+		if tracedFuncs[owner.key] == owner.gen {
+			delete(tracedFuncs, owner.key)
+		}
+		tracedMutex.Unlock()
+	})
+}
+
+// isTraced returns true if fn is a func(interface{}) bool in the registry
+func isTraced(fn interface{}) bool {
+	f, isa := fn.(func(interface{}) bool)
+	if !isa || (f == nil) {
+		return false
+	}
+
+	tracedMutex.RLock()
+	_, traced := tracedFuncs[funcKey(f)]
+	tracedMutex.RUnlock()
+
+	return traced
+}
+
+// newTraceNode returns a traced func that evaluates the fns adapted by FilterAll using eval.
+// When traced, the node is named name, and each func eval calls adds a child.
+func newTraceNode(name string, fns []interface{}, eval func(val interface{}, fns []func(interface{}) bool) bool) func(interface{}) bool {
+	var (
+		adaptedFns = FilterAll(fns...)
+		owner      = &tracedOwner{}
+	)
+
+	fn := func(arg interface{}) bool {
+		tr, tracing := arg.(*tracer)
+		if !tracing {
+			return eval(arg, adaptedFns)
+		}
+
+		// Capture the owner, so that the registration lasts as long as the func
+		runtime.KeepAlive(owner)
+
+		trace := &Trace{Name: name, Input: tr.val}
+		tr.parent.Children = append(tr.parent.Children, trace)
+
+		// Wrap each func to record its evaluation, with whatever value eval passes to it
+		tracedFns := make([]func(interface{}) bool, len(adaptedFns))
+		for i := range adaptedFns {
+			i := i
+			tracedFns[i] = func(val interface{}) bool {
+				return traceCall(adaptedFns[i], fns[i], val, trace)
+			}
+		}
+
+		trace.Result = eval(tr.val, tracedFns)
+		return trace.Result
+	}
+	registerTraced(fn, owner)
+
+	return fn
+}
+
+// traceCall calls fn with val, adding a Trace to parent.
+// If orig is traced, fn is passed a tracer so that it adds its own Trace, otherwise a Trace named by orig is added,
+// where orig is a label or the func to name using funcName.
+func traceCall(fn func(interface{}) bool, orig interface{}, val interface{}, parent *Trace) bool {
+	if isTraced(orig) {
+		return fn(&tracer{val, parent})
+	}
+
+	res := fn(val)
+
+	name, isa := orig.(string)
+	if !isa {
+		name = funcName(orig)
+	}
+	parent.Children = append(parent.Children, &Trace{Name: name, Input: val, Result: res})
+
+	return res
+}
+
+// funcName returns the Go name of a func without the package directory, eg "gofuncs.EqualTo.func1"
+func funcName(fn interface{}) string {
+	if f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()); f != nil {
+		name := f.Name()
+		return name[strings.LastIndex(name, "/")+1:]
+	}
+
+	return "func"
+}
+
+// Named (label, fn) adapts a func(any) bool into a func(interface{}) bool that is labeled in the Trace returned by Explain.
+// Panics if fn is not a valid filter.
+func Named(label string, fn interface{}) func(interface{}) bool {
+	var (
+		adaptedFn = Filter(fn)
+		owner     = &tracedOwner{}
+	)
+
+	named := func(arg interface{}) bool {
+		tr, tracing := arg.(*tracer)
+		if !tracing {
+			return adaptedFn(arg)
+		}
+
+		// Capture the owner, so that the registration lasts as long as the func
+		runtime.KeepAlive(owner)
+
+		if !isTraced(fn) {
+			return traceCall(adaptedFn, label, tr.val, tr.parent)
+		}
+
+		// Trace the func under a temporary parent, then relabel it
+		var temp Trace
+		res := adaptedFn(&tracer{tr.val, &temp})
+
+		trace := temp.Children[0]
+		trace.Name = label
+		tr.parent.Children = append(tr.parent.Children, trace)
+
+		return res
+	}
+	registerTraced(named, owner)
+
+	return named
+}
+
+// Explain (fn, val) evaluates fn for val, and returns a Trace of the evaluation.
+// If fn is returned by And, Or, Not, Xor, Nand, Nor, Implies, AtLeast, AtMost, Exactly, Where, Named, CompileExpr, or Predicate.Filter,
+// the Trace has a child for each func it called, otherwise the Trace is a leaf.
+// Panics if fn is not a valid filter.
+func Explain(fn interface{}, val interface{}) *Trace {
+	if isTraced(fn) {
+		var root Trace
+		fn.(func(interface{}) bool)(&tracer{val, &root})
+
+		return root.Children[0]
+	}
+
+	adaptedFn := Filter(fn)
+	return &Trace{Name: funcName(fn), Input: val, Result: adaptedFn(val)}
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package gofuncs
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func traceIsPositive(i int) bool {
+	return i > 0
+}
+
+func traceIsEven(i int) bool {
+	return i%2 == 0
+}
+
+func TestExplain(t *testing.T) {
+	// A plain func is a leaf named by its Go name
+	assert.Equal(t, &Trace{Name: "gofuncs.traceIsPositive", Input: 2, Result: true}, Explain(traceIsPositive, 2))
+
+	// Named labels a leaf
+	assert.Equal(t, &Trace{Name: "positive", Input: -2, Result: false}, Explain(Named("positive", traceIsPositive), -2))
+
+	// Combinations trace each func called, and skip funcs not called due to short-circuit logic
+	fn := Or(And(Named("positive", traceIsPositive), traceIsEven), Not(Named("even", traceIsEven)))
+	assert.Equal(
+		t,
+		&Trace{
+			Name:   "Or",
+			Input:  -2,
+			Result: false,
+			Children: []*Trace{
+				{
+					Name:     "And",
+					Input:    -2,
+					Result:   false,
+					Children: []*Trace{{Name: "positive", Input: -2, Result: false}},
+				},
+				{
+					Name:     "Not",
+					Input:    -2,
+					Result:   false,
+					Children: []*Trace{{Name: "even", Input: -2, Result: true}},
+				},
+			},
+		},
+		Explain(fn, -2),
+	)
+	assert.Equal(
+		t,
+		"Or(4) = true\n"+
+			"  And(4) = true\n"+
+			"    positive(4) = true\n"+
+			"    gofuncs.traceIsEven(4) = true\n",
+		Explain(fn, 4).String(),
+	)
+
+	// Tracing does not change the result
+	for _, i := range []int{-3, -2, 0, 3, 4} {
+		assert.Equal(t, fn(i), Explain(fn, i).Result)
+	}
+
+	// Named relabels a combination, keeping its children
+	assert.Equal(
+		t,
+		"big even(4) = true\n"+
+			"  positive(4) = true\n"+
+			"  gofuncs.traceIsEven(4) = true\n",
+		Explain(Named("big even", And(Named("positive", traceIsPositive), traceIsEven)), 4).String(),
+	)
+
+	// Counting combinators include n in the name
+	assert.Equal(
+		t,
+		"AtLeast(2)(3) = false\n"+
+			"  gofuncs.traceIsPositive(3) = true\n"+
+			"  gofuncs.traceIsEven(3) = false\n",
+		Explain(AtLeast(2, traceIsPositive, traceIsEven), 3).String(),
+	)
+
+	// Where traces the field value
+	assert.Equal(
+		t,
+		"Where(\"Age\")({Al 20}) = true\n"+
+			"  adult(20) = true\n",
+		Explain(Where("Age", Named("adult", IsGreaterThanEquals(18))), struct {
+			Name string
+			Age  int
+		}{"Al", 20}).String(),
+	)
+
+	// Expressions label each comparison with its source text
+	expr := MustCompileExpr("Age >= 18 && (Name == \"Al\" || Active)")
+	assert.Equal(
+		t,
+		"And(map[Active:false Age:20 Name:Bo]) = false\n"+
+			"  Age >= 18(map[Active:false Age:20 Name:Bo]) = true\n"+
+			"  Or(map[Active:false Age:20 Name:Bo]) = false\n"+
+			"    Name == \"Al\"(map[Active:false Age:20 Name:Bo]) = false\n"+
+			"    Active(map[Active:false Age:20 Name:Bo]) = false\n",
+		Explain(expr, map[string]interface{}{"Name": "Bo", "Age": 20, "Active": false}).String(),
+	)
+
+	// Predicates label each node as it prints
+	pred := PredAnd(PredField("Age", PredGreaterThanEquals(18)), PredNot(PredEqualTo(nil)))
+	assert.Equal(
+		t,
+		"And(map[Age:15]) = false\n"+
+			"  Field(\"Age\")(map[Age:15]) = false\n"+
+			"    GreaterThanEquals(18)(15) = false\n",
+		Explain(pred.Filter(), map[string]interface{}{"Age": 15}).String(),
+	)
+
+	// Traced funcs stay traced when stored in a variable or adapted by Filter
+	positive := Named("positive", traceIsPositive)
+	assert.Equal(
+		t,
+		"Not(2) = false\n"+
+			"  positive(2) = true\n",
+		Explain(Not(Filter(positive)), 2).String(),
+	)
+
+	func() {
+		defer func() {
+			assert.Equal(t, filterErrorMsg, recover())
+		}()
+
+		Explain(1, 2)
+		assert.Fail(t, "must panic")
+	}()
+}
+
+func TestExplainRegistry(t *testing.T) {
+	tracedMutex.RLock()
+	before := len(tracedFuncs)
+	tracedMutex.RUnlock()
+
+	for i := 0; i < 100; i++ {
+		And(traceIsPositive, Named("even", traceIsEven))
+	}
+
+	// Collected funcs are removed by finalizers, which run asynchronously
+	for i := 0; i < 100; i++ {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+
+		tracedMutex.RLock()
+		after := len(tracedFuncs)
+		tracedMutex.RUnlock()
+
+		if after <= before {
+			return
+		}
+	}
+
+	assert.Fail(t, "collected funcs must be removed")
+}